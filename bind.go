@@ -70,9 +70,24 @@ func iconImage(icon icon) *ebiten.Image {
 	return iconMap[icon]
 }
 
+// applyCursor sets the cursor shape decided by resolveCursor.
+func (c *Context) applyCursor() {
+	var shape ebiten.CursorShapeType
+	var ok bool
+	c.cursor, shape, ok = resolveCursor(c.cursor, ebiten.CursorShape(), c.cursorShape, c.cursorOverUI)
+	if ok {
+		ebiten.SetCursorShape(shape)
+	}
+}
+
 func (c *Context) updateInput() {
+	// the cursor is applied only when it moves so that it doesn't override the pointer moved by touches
 	cx, cy := ebiten.CursorPosition()
-	c.inputMouseMove(cx, cy)
+	if image.Pt(cx, cy) != c.cursorPos {
+		c.cursorPos = image.Pt(cx, cy)
+		c.touchInput = false
+		c.inputMouseMove(cx, cy)
+	}
 	if wx, wy := ebiten.Wheel(); wx != 0 || wy != 0 {
 		c.inputScroll(int(wx*-30), int(wy*-30))
 	}
@@ -86,16 +101,73 @@ func (c *Context) updateInput() {
 	} else if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonRight) {
 		c.inputMouseUp(cx, cy, ebiten.MouseButtonRight)
 	}
-	for _, k := range []ebiten.Key{ebiten.KeyAlt, ebiten.KeyBackspace, ebiten.KeyControl, ebiten.KeyEnter, ebiten.KeyShift} {
+	c.keys = inpututil.AppendJustReleasedKeys(c.keys[:0])
+	for _, k := range c.keys {
+		c.inputKeyUp(k)
+	}
+	c.keys = inpututil.AppendPressedKeys(c.keys[:0])
+	for _, k := range c.keys {
 		if inpututil.IsKeyJustPressed(k) {
 			c.inputKeyDown(k)
-		} else if inpututil.IsKeyJustReleased(k) {
-			c.inputKeyUp(k)
+		} else {
+			c.inputKeyHold(k, inpututil.KeyPressDuration(k))
+		}
+	}
+
+	for _, s := range c.shortcuts {
+		if inpututil.IsKeyJustPressed(s.key) {
+			c.inputShortcutKey(s.key)
+		}
+	}
+
+	c.touchIDs = inpututil.AppendJustReleasedTouchIDs(c.touchIDs[:0])
+	for _, id := range c.touchIDs {
+		x, y := inpututil.TouchPositionInPreviousTick(id)
+		c.inputTouchUp(id, x, y)
+	}
+	c.touchIDs = ebiten.AppendTouchIDs(c.touchIDs[:0])
+	for _, id := range c.touchIDs {
+		x, y := ebiten.TouchPosition(id)
+		if _, ok := c.touches[id]; ok {
+			c.inputTouchMove(id, x, y)
+		} else {
+			c.inputTouchDown(id, x, y)
+		}
+	}
+	c.inputTouchHold()
+
+	c.gamepadIDs = ebiten.AppendGamepadIDs(c.gamepadIDs[:0])
+	for _, id := range c.gamepadIDs {
+		if !ebiten.IsStandardGamepadLayoutAvailable(id) {
+			continue
+		}
+		for _, b := range []ebiten.StandardGamepadButton{
+			ebiten.StandardGamepadButtonLeftTop, ebiten.StandardGamepadButtonLeftBottom,
+			ebiten.StandardGamepadButtonLeftLeft, ebiten.StandardGamepadButtonLeftRight,
+			ebiten.StandardGamepadButtonRightBottom, ebiten.StandardGamepadButtonRightRight,
+			ebiten.StandardGamepadButtonFrontTopLeft, ebiten.StandardGamepadButtonFrontTopRight,
+		} {
+			if inpututil.IsStandardGamepadButtonJustPressed(id, b) {
+				c.inputGamepadButtonPress(b)
+			}
+		}
+		// scroll by the sticks
+		for _, axes := range [][2]ebiten.StandardGamepadAxis{
+			{ebiten.StandardGamepadAxisLeftStickHorizontal, ebiten.StandardGamepadAxisLeftStickVertical},
+			{ebiten.StandardGamepadAxisRightStickHorizontal, ebiten.StandardGamepadAxisRightStickVertical},
+		} {
+			x := ebiten.StandardGamepadAxisValue(id, axes[0])
+			y := ebiten.StandardGamepadAxisValue(id, axes[1])
+			if x*x+y*y < gamepadDeadZone*gamepadDeadZone {
+				continue
+			}
+			c.inputScroll(int(x*gamepadScrollSpeed), int(y*gamepadScrollSpeed))
 		}
 	}
 }
 
 func (c *Context) draw(screen *ebiten.Image) {
+	c.screenSize = screen.Bounds().Size()
 	target := screen
 	var cmd *command
 	for c.nextCommand(&cmd) {
@@ -126,6 +198,16 @@ func (c *Context) draw(screen *ebiten.Image) {
 			op.GeoM.Translate(float64(x), float64(y))
 			op.ColorScale.ScaleWithColor(cmd.icon.color)
 			target.DrawImage(img, op)
+		case commandImage:
+			b := cmd.image.img.Bounds()
+			if b.Empty() || cmd.image.rect.Empty() {
+				continue
+			}
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(float64(cmd.image.rect.Dx())/float64(b.Dx()), float64(cmd.image.rect.Dy())/float64(b.Dy()))
+			op.GeoM.Translate(float64(cmd.image.rect.Min.X), float64(cmd.image.rect.Min.Y))
+			op.ColorScale.ScaleWithColor(cmd.image.color)
+			target.DrawImage(cmd.image.img, op)
 		case commandDraw:
 			cmd.draw.f(target)
 		case commandClip:
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+// Clipboard is the interface to read and write the clipboard used by text boxes.
+//
+// Ebitengine has no clipboard API, so by default an in-memory clipboard is used,
+// which works without any window system, e.g. in headless tests.
+// Use DebugUI.SetClipboard to connect the system clipboard.
+type Clipboard interface {
+	ReadText() (string, error)
+	WriteText(text string) error
+}
+
+// memoryClipboard is a Clipboard that keeps the text in memory.
+type memoryClipboard struct {
+	text string
+}
+
+func (m *memoryClipboard) ReadText() (string, error) {
+	return m.text, nil
+}
+
+func (m *memoryClipboard) WriteText(text string) error {
+	m.text = text
+	return nil
+}
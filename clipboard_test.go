@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"testing"
+)
+
+func TestMemoryClipboard(t *testing.T) {
+	d := New()
+	d.SetClipboard(nil)
+	cb := d.ctx.clipboard
+	if _, ok := cb.(*memoryClipboard); !ok {
+		t.Fatalf("SetClipboard(nil): clipboard is %T, want *memoryClipboard", cb)
+	}
+
+	if got, err := cb.ReadText(); err != nil || got != "" {
+		t.Errorf("ReadText() = (%q, %v), want (%q, nil)", got, err, "")
+	}
+	for _, text := range []string{"foo", "", "multi\nline"} {
+		if err := cb.WriteText(text); err != nil {
+			t.Fatalf("WriteText(%q) failed: %v", text, err)
+		}
+		if got, err := cb.ReadText(); err != nil || got != text {
+			t.Errorf("ReadText() = (%q, %v), want (%q, nil)", got, err, text)
+		}
+	}
+}
@@ -113,6 +113,26 @@ func (c *Context) drawIcon(icon icon, rect image.Rectangle, color color.Color) {
 	}
 }
 
+func (c *Context) drawImage(img *ebiten.Image, rect image.Rectangle, color color.Color) {
+	// do clip command if the rect isn't fully contained within the cliprect
+	clipped := c.checkClip(rect)
+	if clipped == clipAll {
+		return
+	}
+	if clipped == clipPart {
+		c.setClip(c.clipRect())
+	}
+	// do image command
+	cmd := c.pushCommand(commandImage)
+	cmd.image.img = img
+	cmd.image.rect = rect
+	cmd.image.color = color
+	// reset clipping if it was set
+	if clipped != 0 {
+		c.setClip(unclippedRect)
+	}
+}
+
 func (c *Context) DrawControl(f func(screen *ebiten.Image)) {
 	c.setClip(c.clipRect())
 	defer c.setClip(unclippedRect)
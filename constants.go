@@ -6,11 +6,74 @@ package debugui
 import (
 	"image"
 	"image/color"
+	"time"
 )
 
 const (
 	containerPoolSize = 48
 	treeNodePoolSize  = 48
+	undoHistorySize   = 256
+	perfHistorySize   = 120
+)
+
+// undoLabelMaxLength is the maximum number of runes of a text shown in an undo entry label.
+const undoLabelMaxLength = 16
+
+// perfMemStatsInterval is the interval at which the performance window reads the memory statistics.
+const perfMemStatsInterval = time.Second
+
+const (
+	// gamepadDeadZone is the stick deflection below which the stick is ignored.
+	gamepadDeadZone = 0.25
+
+	// gamepadScrollSpeed is the scroll amount in pixels per tick with a stick fully deflected.
+	gamepadScrollSpeed = 10
+)
+
+const (
+	// doubleClickTicks is the maximum number of ticks between two clicks of a double-click.
+	doubleClickTicks = 30
+
+	// touchLongPressTicks is the number of ticks a touch has to be held in place to make a right click.
+	touchLongPressTicks = 30
+
+	// keyRepeatDelay is the number of ticks a key has to be held before it repeats.
+	keyRepeatDelay = 30
+
+	// keyRepeatInterval is the number of ticks between repeats of a held key.
+	keyRepeatInterval = 3
+)
+
+const (
+	defaultTooltipDelay = 30
+	tooltipMaxWidth     = 300
+	tooltipOffset       = 16
+)
+
+// dragThreshold is the distance the mouse has to move with the button held before dragging starts.
+const dragThreshold = 4
+
+const (
+	// windowMinWidth and windowMinHeight are the default minimum size of a window.
+	windowMinWidth  = 96
+	windowMinHeight = 64
+
+	// windowResizeBorder is the thickness of the resize handles at the edges of a window.
+	windowResizeBorder = 4
+
+	// windowSnapDistance is the distance within which a window snaps to an edge.
+	windowSnapDistance = 8
+)
+
+const (
+	// tableMinColumnWidth is the minimum width a table column can be resized to.
+	tableMinColumnWidth = 16
+
+	// tableResizeKeyStep is the amount a table column is resized by an arrow key.
+	tableResizeKeyStep = 8
+
+	// inspectLabelWidth is the width of the label column of Inspect.
+	inspectLabelWidth = 100
 )
 
 const (
@@ -26,6 +89,7 @@ var defaultStyle style = style{
 	titleHeight:   24,
 	scrollbarSize: 12,
 	thumbSize:     8,
+	touchSlop:     8,
 	colors: [...]color.RGBA{
 		{230, 230, 230, 255}, // MU_COLOR_TEXT
 		{25, 25, 25, 255},    // MU_COLOR_BORDER
@@ -41,6 +105,8 @@ var defaultStyle style = style{
 		{40, 40, 40, 255},    // MU_COLOR_BASEFOCUS
 		{43, 43, 43, 255},    // MU_COLOR_SCROLLBASE
 		{30, 30, 30, 255},    // MU_COLOR_SCROLLTHUMB
+		{110, 35, 35, 255},   // ColorInvalid
+		{240, 180, 60, 255},  // ColorFocusRing
 	},
 }
 
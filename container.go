@@ -1,13 +1,17 @@
 package debugui
 
-import "strings"
+import (
+	"image"
+	"strings"
+)
 
 type container struct {
-	layout  Layout
-	headIdx int
-	tailIdx int
-	zIndex  int
-	open    bool
+	layout   Layout
+	headIdx  int
+	tailIdx  int
+	zIndex   int
+	open     bool
+	dragRect image.Rectangle
 }
 
 func (c *container) SetOpen(state bool) {
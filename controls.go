@@ -9,9 +9,11 @@ import (
 	"math"
 	"os"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 	"unsafe"
 
+	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/exp/textinput"
 )
 
@@ -61,7 +63,11 @@ func (c *Context) drawControlText(str string, rect image.Rectangle, colorid int,
 }
 
 // mouseOver checks if the mouse position is within the given rectangle, the clip rectangle, and the hover root.
+// When the pointer is moved by touches, the rectangle is extended by the touch slop of the style.
 func (c *Context) mouseOver(rect image.Rectangle) bool {
+	if c.touchInput {
+		rect = rect.Inset(-c.style.touchSlop)
+	}
 	return c.mousePos.In(rect) && c.mousePos.In(c.clipRect()) && c.inHoverRoot()
 }
 
@@ -87,7 +93,7 @@ func (c *Context) updateControl(id controlID, rect image.Rectangle, opt option)
 		if c.mousePressed != 0 && !mouseover {
 			c.setFocus(0)
 		}
-		if c.mouseDown == 0 && (^opt&optionHoldFocus) != 0 {
+		if c.mouseDown == 0 && (^opt&optionHoldFocus) != 0 && !c.navFocus {
 			c.setFocus(0)
 		}
 	}
@@ -111,9 +117,18 @@ func (c *Context) Control(idStr string, f func(r image.Rectangle) Response) Resp
 // control manages a UI control within a given layout and executes a callback function with the control's rectangle.
 // It updates the control's state based on the provided id and options, returning the callback's response.
 func (c *Context) control(id controlID, opt option, f func(r image.Rectangle) Response) Response {
-	r := c.layoutNext()
+	return c.controlAt(id, c.layoutNext(), opt, f)
+}
+
+// controlAt is like control, but places the control at r instead of the next layout cell.
+func (c *Context) controlAt(id controlID, r image.Rectangle, opt option, f func(r image.Rectangle) Response) Response {
 	c.updateControl(id, r, opt)
-	return f(r)
+	if (opt & optionNoInteract) == 0 {
+		c.registerFocus(id, r)
+	}
+	res := f(r)
+	c.drawFocusRing(id, r)
+	return res
 }
 
 // Text renders the provided text string within the context, wrapping it within the available width of the layout.
@@ -171,9 +186,10 @@ func (c *Context) button(label string, idStr string, opt option) Response {
 	return c.control(id, opt, func(r image.Rectangle) Response {
 		var res Response
 		// handle click
-		if c.mousePressed == mouseLeft && c.focus == id {
+		if (c.mousePressed == mouseLeft && c.focus == id) || c.navActivated(id) {
 			res |= ResponseSubmit
 		}
+		c.setCursor(id, ebiten.CursorShapePointer)
 		// draw
 		c.drawControlFrame(id, r, ColorButton, opt)
 		if len(label) > 0 {
@@ -190,16 +206,18 @@ func (c *Context) button(label string, idStr string, opt option) Response {
 func (c *Context) Checkbox(label string, state *bool) Response {
 	id := c.pushID(ptrToBytes(unsafe.Pointer(state)))
 	defer c.popID()
+	defer recordUndo(c, id, state, *state)
 
 	return c.control(id, 0, func(r image.Rectangle) Response {
 		var res Response
 		box := image.Rect(r.Min.X, r.Min.Y, r.Min.X+r.Dy(), r.Max.Y)
 		c.updateControl(id, r, 0)
 		// handle click
-		if c.mousePressed == mouseLeft && c.focus == id {
+		if (c.mousePressed == mouseLeft && c.focus == id) || c.navActivated(id) {
 			res |= ResponseChange
 			*state = !*state
 		}
+		c.setCursor(id, ebiten.CursorShapePointer)
 		// draw
 		c.drawControlFrame(id, box, ColorBase, 0)
 		if *state {
@@ -226,73 +244,258 @@ func (c *Context) textField(id controlID) *textinput.Field {
 }
 
 // textBoxRaw manages low-level text box rendering and behavior, handling user interaction, keyboard input, and focus state.
-// Handles text input, editing, focus, key presses (backspace/return), and updates the text buffer if changes occur.
-// Draws the text box and its contents based on the current state and specified options.
+// Handles text input, caret movement, selection by mouse and shift+keys, and clipboard operations through ctrl+A/C/X/V.
+// With optionPassword, the text is displayed masked and cannot be copied. With optionReadOnly, the text can be selected
+// and copied but not edited.
+// If filter is not nil, inserted runes for which filter returns false are dropped. If validate is not nil and returns
+// false for the text, the text box is drawn with ColorInvalid and cannot be submitted.
+// Draws the text box, the selection highlight and the caret based on the current state and specified options.
 // Returns a Response indicating whether the text has changed or the textbox was submitted.
-func (c *Context) textBoxRaw(buf *string, id controlID, opt option) Response {
+func (c *Context) textBoxRaw(buf *string, id controlID, opt option, filter func(r rune) bool, validate func(text string) bool) Response {
 	return c.control(id, opt|optionHoldFocus, func(r image.Rectangle) Response {
+		c.setCursor(id, ebiten.CursorShapeText)
 		var res Response
 
+		readOnly := (opt & optionReadOnly) != 0
+		password := (opt & optionPassword) != 0
+
+		f := c.textField(id)
 		if c.focus == id {
-			// handle text input
-			f := c.textField(id)
-			f.Focus()
-			x := r.Min.X + c.style.padding + textWidth(*buf)
-			y := r.Min.Y + lineHeight()
-			handled, err := f.HandleInput(x, y)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err)
-				return 0
+			c.textEditing = true
+
+			// start editing the current text when the field gets focused
+			if !f.IsFocused() || (readOnly && f.Text() != *buf) {
+				f.SetTextAndSelection(*buf, len(*buf), len(*buf))
+				f.Focus()
 			}
-			if *buf != f.TextForRendering() {
-				*buf = f.TextForRendering()
-				res |= ResponseChange
+
+			// handle mouse selection
+			if (c.mouseDown & mouseLeft) != 0 {
+				anchor, caret := c.textSelection(f, len(*buf))
+				p := textBoxOffset(*buf, c.mousePos.X-c.textBoxX(*buf, caret, r, opt), opt)
+				if c.mousePressed == mouseLeft && c.clickCount >= 2 {
+					if password {
+						anchor, caret = 0, len(*buf)
+					} else {
+						anchor, caret = wordBounds(*buf, p)
+					}
+				} else if c.mousePressed == mouseLeft && (c.keyDown&keyShift) == 0 {
+					anchor, caret = p, p
+				} else {
+					caret = p
+				}
+				if lo, hi := f.Selection(); lo != min(anchor, caret) || hi != max(anchor, caret) {
+					c.setTextSelection(f, *buf, anchor, caret)
+				}
+			}
+
+			// handle text input
+			var handled bool
+			if !readOnly {
+				_, caret := c.textSelection(f, len(*buf))
+				x := c.textBoxX(*buf, caret, r, opt) + textBoxWidth(*buf, caret, opt)
+				y := r.Min.Y + lineHeight()
+				committed := f.Text()
+				var err error
+				handled, err = f.HandleInput(x, y)
+				if err != nil {
+					fmt.Fprintln(os.Stderr, err)
+					return 0
+				}
+				// drop the rejected runes from the committed input
+				if filter != nil && f.Text() != committed {
+					if text, p := filterInserted(committed, f.Text(), filter); text != f.Text() {
+						f.SetTextAndSelection(text, p, p)
+					}
+				}
+				if *buf != f.TextForRendering() {
+					*buf = f.TextForRendering()
+					res |= ResponseChange
+				}
 			}
 
 			if !handled {
-				// handle backspace
-				if (c.keyPressed&keyBackspace) != 0 && len(*buf) > 0 {
-					_, size := utf8.DecodeLastRuneInString(*buf)
-					*buf = (*buf)[:len(*buf)-size]
-					f.SetTextAndSelection(*buf, len(*buf), len(*buf))
+				text := *buf
+				anchor, caret := c.textSelection(f, len(text))
+				lastAnchor, lastCaret := anchor, caret
+				lo, hi := min(anchor, caret), max(anchor, caret)
+				shift := (c.keyDown & keyShift) != 0
+				ctrl := (c.keyDown & keyControl) != 0
+
+				// move the caret, extending the selection if shift is held
+				move := func(p int) {
+					caret = p
+					if !shift {
+						anchor = p
+					}
+				}
+
+				switch {
+				case (c.keyPressed & keyLeft) != 0:
+					if lo != hi && !shift {
+						move(lo)
+					} else {
+						_, size := utf8.DecodeLastRuneInString(text[:caret])
+						move(caret - size)
+					}
+				case (c.keyPressed & keyRight) != 0:
+					if lo != hi && !shift {
+						move(hi)
+					} else {
+						_, size := utf8.DecodeRuneInString(text[caret:])
+						move(caret + size)
+					}
+				case (c.keyPressed & keyHome) != 0:
+					move(0)
+				case (c.keyPressed & keyEnd) != 0:
+					move(len(text))
+				case !readOnly && (c.keyPressed&keyBackspace) != 0:
+					if lo == hi {
+						_, size := utf8.DecodeLastRuneInString(text[:lo])
+						lo -= size
+					}
+					text = text[:lo] + text[hi:]
+					anchor, caret = lo, lo
+				case !readOnly && (c.keyPressed&keyDelete) != 0:
+					if lo == hi {
+						_, size := utf8.DecodeRuneInString(text[hi:])
+						hi += size
+					}
+					text = text[:lo] + text[hi:]
+					anchor, caret = lo, lo
+				case ctrl && (c.keyPressed&keyA) != 0:
+					anchor, caret = 0, len(text)
+				case ctrl && (c.keyPressed&(keyC|keyX)) != 0 && lo != hi && !password:
+					if err := c.clipboard.WriteText(text[lo:hi]); err != nil {
+						fmt.Fprintln(os.Stderr, err)
+						break
+					}
+					if (c.keyPressed&keyX) != 0 && !readOnly {
+						text = text[:lo] + text[hi:]
+						anchor, caret = lo, lo
+					}
+				case !readOnly && ctrl && (c.keyPressed&keyV) != 0:
+					str, err := c.clipboard.ReadText()
+					if err != nil {
+						fmt.Fprintln(os.Stderr, err)
+						break
+					}
+					// a text box is a single line
+					str = strings.ReplaceAll(strings.ReplaceAll(str, "\r\n", " "), "\n", " ")
+					if filter != nil {
+						str = filterText(str, filter)
+					}
+					text = text[:lo] + str + text[hi:]
+					anchor, caret = lo+len(str), lo+len(str)
+				}
+
+				if text != *buf {
+					*buf = text
 					res |= ResponseChange
 				}
+				if (res&ResponseChange) != 0 || anchor != lastAnchor || caret != lastCaret {
+					c.setTextSelection(f, text, anchor, caret)
+				}
 
 				// handle return
-				if (c.keyPressed & keyReturn) != 0 {
+				if !readOnly && (c.keyPressed&keyReturn) != 0 && (validate == nil || validate(text)) {
 					c.setFocus(0)
 					res |= ResponseSubmit
 					f.SetTextAndSelection("", 0, 0)
 				}
 			}
-		} else {
-			f := c.textField(id)
-			if *buf != f.TextForRendering() {
-				f.SetTextAndSelection(*buf, len(*buf), len(*buf))
-			}
+		} else if *buf != f.TextForRendering() {
+			f.SetTextAndSelection(*buf, len(*buf), len(*buf))
 		}
 
 		// draw
-		c.drawControlFrame(id, r, ColorBase, opt)
+		if validate != nil && !validate(*buf) {
+			if (opt & optionNoFrame) == 0 {
+				c.drawFrame(r, ColorInvalid)
+			}
+		} else {
+			c.drawControlFrame(id, r, ColorBase, opt)
+		}
 		if c.focus == id {
 			color := c.style.colors[ColorText]
-			textw := textWidth(*buf)
+			anchor, caret := c.textSelection(f, len(*buf))
+			lo, hi := min(anchor, caret), max(anchor, caret)
 			texth := lineHeight()
-			ofx := r.Dx() - c.style.padding - textw - 1
-			textx := r.Min.X + min(ofx, c.style.padding)
+			textx := c.textBoxX(*buf, caret, r, opt)
 			texty := r.Min.Y + (r.Dy()-texth)/2
 			c.pushClipRect(r)
-			c.drawText(*buf, image.Pt(textx, texty), color)
-			c.drawRect(image.Rect(textx+textw, texty, textx+textw+1, texty+texth), color)
+			if lo != hi {
+				x0 := textx + textBoxWidth(*buf, lo, opt)
+				x1 := textx + textBoxWidth(*buf, hi, opt)
+				c.drawRect(image.Rect(x0, texty, x1, texty+texth), c.style.colors[ColorButtonFocus])
+			}
+			c.drawText(textBoxText(*buf, opt), image.Pt(textx, texty), color)
+			caretx := textx + textBoxWidth(*buf, caret, opt)
+			c.drawRect(image.Rect(caretx, texty, caretx+1, texty+texth), color)
 			c.popClipRect()
 		} else {
-			c.drawControlText(*buf, r, ColorText, opt)
+			c.drawControlText(textBoxText(*buf, opt), r, ColorText, opt)
 		}
 		return res
 	})
 }
 
+// textBoxText returns the text of a text box as displayed. The text of a password text box is masked.
+func textBoxText(str string, opt option) string {
+	if (opt & optionPassword) != 0 {
+		return strings.Repeat("*", utf8.RuneCountInString(str))
+	}
+	return str
+}
+
+// textBoxWidth returns the displayed width of the text of a text box up to the byte offset p.
+func textBoxWidth(str string, p int, opt option) int {
+	return textWidth(textBoxText(str[:p], opt))
+}
+
+// textBoxOffset returns the byte offset in the text of a text box whose caret position is the nearest to x,
+// relative to the origin of the displayed text.
+func textBoxOffset(str string, x int, opt option) int {
+	disp := textBoxText(str, opt)
+	p := offsetAtX(disp, textLine{end: len(disp), next: len(disp)}, x)
+	if (opt & optionPassword) == 0 {
+		return p
+	}
+	// each rune of a password is displayed as one byte
+	var i int
+	for ; p > 0; p-- {
+		_, size := utf8.DecodeRuneInString(str[i:])
+		i += size
+	}
+	return i
+}
+
+// textBoxX returns the x coordinate where the text of a focused text box starts.
+// The text is shifted to the left when needed so that the caret stays visible.
+func (c *Context) textBoxX(str string, caret int, r image.Rectangle, opt option) int {
+	ofx := r.Dx() - c.style.padding - textBoxWidth(str, caret, opt) - 1
+	return r.Min.X + min(ofx, c.style.padding)
+}
+
+// textSelection returns the anchor and the caret of the focused text field's selection, clamped to n.
+func (c *Context) textSelection(f *textinput.Field, n int) (anchor, caret int) {
+	start, end := f.Selection()
+	start, end = min(start, n), min(end, n)
+	if start != end && c.textAnchor == end {
+		return end, start
+	}
+	return start, end
+}
+
+// setTextSelection sets the text and the selection of the focused text field, remembering which end is the anchor.
+func (c *Context) setTextSelection(f *textinput.Field, text string, anchor, caret int) {
+	f.SetTextAndSelection(text, min(anchor, caret), max(anchor, caret))
+	c.textAnchor = anchor
+}
+
 // numberTextBox renders an editable numeric text box tied to a float64 value and handles input and focus behavior.
+// Only characters of a number can be typed. Invalid input cannot be submitted and is discarded when the focus is lost,
+// keeping the previous value.
 func (c *Context) numberTextBox(value *float64, id controlID) bool {
 	if c.mousePressed == mouseLeft && (c.keyDown&keyShift) != 0 &&
 		c.hover == id {
@@ -300,13 +503,11 @@ func (c *Context) numberTextBox(value *float64, id controlID) bool {
 		c.numberEditBuf = fmt.Sprintf(realFmt, *value)
 	}
 	if c.numberEdit == id {
-		res := c.textBoxRaw(&c.numberEditBuf, id, 0)
+		res := c.textBoxRaw(&c.numberEditBuf, id, 0, isNumberRune, isNumberText)
 		if (res&ResponseSubmit) != 0 || c.focus != id {
-			nval, err := strconv.ParseFloat(c.numberEditBuf, 32)
-			if err != nil {
-				nval = 0
+			if nval, err := strconv.ParseFloat(c.numberEditBuf, 32); err == nil {
+				*value = float64(nval)
 			}
-			*value = float64(nval)
 			c.numberEdit = 0
 		}
 		return true
@@ -317,11 +518,27 @@ func (c *Context) numberTextBox(value *float64, id controlID) bool {
 // textBox updates the text input box based on a provided string buffer and options, returning a Response status.
 // It uniquely identifies the text box by generating an ID from the buffer's memory address.
 // The method interacts with textBoxRaw to handle the input box rendering and behavior using the computed ID and options.
-func (c *Context) textBox(buf *string, opt option) Response {
+func (c *Context) textBox(buf *string, opt option, filter func(r rune) bool, validate func(text string) bool) Response {
 	id := c.pushID(ptrToBytes(unsafe.Pointer(buf)))
 	defer c.popID()
+	if (opt & optionPassword) != 0 {
+		defer recordMaskedUndo(c, id, buf, *buf)
+	} else {
+		defer recordUndo(c, id, buf, *buf)
+	}
+
+	return c.textBoxRaw(buf, id, opt, filter, validate)
+}
+
+// isNumberRune reports whether r can be a part of a number.
+func isNumberRune(r rune) bool {
+	return (r >= '0' && r <= '9') || strings.ContainsRune("+-.eE", r)
+}
 
-	return c.textBoxRaw(buf, id, opt)
+// isNumberText reports whether text is a valid number.
+func isNumberText(text string) bool {
+	_, err := strconv.ParseFloat(text, 32)
+	return err == nil
 }
 
 // formatNumber formats a floating-point number `v` to a string with a specified number of decimal places `digits`.
@@ -338,6 +555,9 @@ func (c *Context) slider(value *float64, low, high, step float64, digits int, op
 	v := last
 	id := c.pushID(ptrToBytes(unsafe.Pointer(value)))
 	defer c.popID()
+	if (opt & optionNoUndo) == 0 {
+		defer recordUndo(c, id, value, last)
+	}
 
 	// handle text input mode
 	if c.numberTextBox(&v, id) {
@@ -354,6 +574,13 @@ func (c *Context) slider(value *float64, low, high, step float64, digits int, op
 				v = math.Round(v/step) * step
 			}
 		}
+		if d := c.keyStep(id); d != 0 {
+			if step != 0 {
+				v += d * step
+			} else {
+				v += d * (high - low) / 100
+			}
+		}
 		// clamp and store value, update res
 		*value = clampF(v, low, high)
 		v = *value
@@ -382,6 +609,9 @@ func (c *Context) number(value *float64, step float64, digits int, opt option) R
 	id := c.pushID(ptrToBytes(unsafe.Pointer(value)))
 	defer c.popID()
 	last := *value
+	if (opt & optionNoUndo) == 0 {
+		defer recordUndo(c, id, value, last)
+	}
 
 	// handle text input mode
 	if c.numberTextBox(value, id) {
@@ -395,6 +625,7 @@ func (c *Context) number(value *float64, step float64, digits int, opt option) R
 		if c.focus == id && c.mouseDown == mouseLeft {
 			*value += float64(c.mouseDelta.X) * step
 		}
+		*value += c.keyStep(id) * step
 		// set flag if value changed
 		if *value != last {
 			res |= ResponseChange
@@ -410,6 +641,99 @@ func (c *Context) number(value *float64, step float64, digits int, opt option) R
 	})
 }
 
+// vecAxisLabels holds the component labels used by vec, in component order.
+const vecAxisLabels = "XYZW"
+
+// vecState retrieves or initializes the retained lock and reset state of a vector control.
+// The defaults are taken from the values the first time the control is shown.
+func (c *Context) vecState(id controlID, values []float64) *vecState {
+	if _, ok := c.vecStates[id]; !ok {
+		if c.vecStates == nil {
+			c.vecStates = make(map[controlID]*vecState)
+		}
+		st := &vecState{}
+		copy(st.defaults[:], values)
+		c.vecStates[id] = st
+	}
+	return c.vecStates[id]
+}
+
+// vec renders one number field per component of values in a single row, sharing one ID scope.
+// Each component has an axis label that toggles its lock and a reset button that restores its default value.
+// Locked components cannot be edited. Returns the combined Response of all the components.
+func (c *Context) vec(values []float64, step float64, digits int, opt option) Response {
+	id := c.pushID(ptrToBytes(unsafe.Pointer(&values[0])))
+	defer c.popID()
+	st := c.vecState(id, values)
+
+	var res Response
+	c.LayoutColumn(func() {
+		// each component takes a lock toggle, a number field and a reset button
+		n := len(values)
+		bw := c.style.size.Y + c.style.padding*2
+		w := (c.layout().body.Dx() - 2*n*bw - (3*n-1)*c.style.spacing) / n
+		widths := make([]int, 0, 3*n)
+		for i := 0; i < n; i++ {
+			widths = append(widths, bw, max(w, 1), bw)
+		}
+		c.SetLayoutRow(widths, 0)
+
+		for i := range values {
+			axis := vecAxisLabels[i : i+1]
+
+			// lock toggle
+			lockID := c.idFromBytes([]byte("!lock" + axis))
+			c.control(lockID, 0, func(r image.Rectangle) Response {
+				if (c.mousePressed == mouseLeft && c.focus == lockID) || c.navActivated(lockID) {
+					st.locked[i] = !st.locked[i]
+				}
+				if st.locked[i] {
+					c.drawFrame(r, ColorButtonFocus)
+				} else {
+					c.drawControlFrame(lockID, r, ColorButton, 0)
+				}
+				c.drawControlText(axis, r, ColorText, optionAlignCenter)
+				return 0
+			})
+
+			var lockOpt option
+			if st.locked[i] {
+				lockOpt = optionNoInteract
+			}
+
+			// value, identified as number does so that a reset coalesces with its edits in the undo history
+			numberID := c.idFromBytes(ptrToBytes(unsafe.Pointer(&values[i])))
+			res |= c.number(&values[i], step, digits, opt|lockOpt)
+
+			// reset button
+			if c.button("R", "!reset"+axis, optionAlignCenter|lockOpt) != 0 && values[i] != st.defaults[i] {
+				before := values[i]
+				values[i] = st.defaults[i]
+				recordUndo(c, numberID, &values[i], before)
+				res |= ResponseChange
+			}
+		}
+	})
+	return res
+}
+
+// progressBar renders a non-interactive bar filled according to fraction, clamped to [0, 1].
+// If overlay is not empty, it is drawn centered over the bar.
+func (c *Context) progressBar(fraction float64, overlay string, opt option) {
+	c.control(0, opt, func(r image.Rectangle) Response {
+		c.drawFrame(r, ColorBase)
+		fill := r
+		fill.Max.X = r.Min.X + int(clampF(fraction, 0, 1)*float64(r.Dx()))
+		if fill.Dx() > 0 {
+			c.drawRect(fill, c.style.colors[ColorButtonFocus])
+		}
+		if len(overlay) > 0 {
+			c.drawControlText(overlay, r, ColorText, opt)
+		}
+		return 0
+	})
+}
+
 // header creates and manages a header control with an optional tree node state, label, and ID. Returns a Response.
 func (c *Context) header(label string, idStr string, istreenode bool, opt option) Response {
 	var id controlID
@@ -434,7 +758,8 @@ func (c *Context) header(label string, idStr string, istreenode bool, opt option
 
 	return c.control(id, 0, func(r image.Rectangle) Response {
 		// handle click (TODO (port): check if this is correct)
-		clicked := c.mousePressed == mouseLeft && c.focus == id
+		clicked := (c.mousePressed == mouseLeft && c.focus == id) || c.navActivated(id)
+		c.setCursor(id, ebiten.CursorShapePointer)
 		v1, v2 := 0, 0
 		if active {
 			v1 = 1
@@ -616,7 +941,7 @@ func (c *Context) pushContainerBody(cnt *container, body image.Rectangle, opt op
 // The rect parameter defines the initial size and position of the window.
 // opt specifies additional options such as disabling the frame, resize, or title, and enabling popup or auto-sizing functionality.
 // f is a callback function that provides the response and layout details of the window to the caller.
-func (c *Context) window(title string, idStr string, rect image.Rectangle, opt option, f func(res Response, layout Layout)) {
+func (c *Context) window(title string, idStr string, rect image.Rectangle, opt option, options *WindowOptions, f func(res Response, layout Layout)) {
 	var id controlID
 	if len(idStr) > 0 {
 		id = c.pushID([]byte(idStr))
@@ -640,6 +965,12 @@ func (c *Context) window(title string, idStr string, rect image.Rectangle, opt o
 	c.containerStack = append(c.containerStack, cnt)
 	defer c.popContainer()
 
+	prevRoot := c.rootContainer
+	c.rootContainer = cnt
+	defer func() {
+		c.rootContainer = prevRoot
+	}()
+
 	// push container to roots list and push head command
 	c.rootList = append(c.rootList, cnt)
 	cnt.headIdx = c.pushJump(-1)
@@ -683,7 +1014,8 @@ func (c *Context) window(title string, idStr string, rect image.Rectangle, opt o
 			c.updateControl(id, tr, opt)
 			c.drawControlText(title, tr, ColorTitleText, opt)
 			if id == c.focus && c.mouseDown == mouseLeft {
-				cnt.layout.Rect = cnt.layout.Rect.Add(c.mouseDelta)
+				c.dragWindow(cnt, image.Point{}, options)
+				c.setCursor(id, ebiten.CursorShapeMove)
 			}
 			body.Min.Y += tr.Dy()
 		}
@@ -698,20 +1030,37 @@ func (c *Context) window(title string, idStr string, rect image.Rectangle, opt o
 			if c.mousePressed == mouseLeft && id == c.focus {
 				cnt.open = false
 			}
+			c.setCursor(id, ebiten.CursorShapePointer)
 		}
 	}
 
 	c.pushContainerBody(cnt, body, opt)
 
-	// do `resize` handle
+	// do `resize` handles at the edges and the corners
 	if (^opt & optionNoResize) != 0 {
-		sz := c.style.titleHeight
-		id := c.idFromBytes([]byte("!resize"))
-		r := image.Rect(rect.Max.X-sz, rect.Max.Y-sz, rect.Max.X, rect.Max.Y)
-		c.updateControl(id, r, opt)
-		if id == c.focus && c.mouseDown == mouseLeft {
-			cnt.layout.Rect.Max.X = cnt.layout.Rect.Min.X + max(96, cnt.layout.Rect.Dx()+c.mouseDelta.X)
-			cnt.layout.Rect.Max.Y = cnt.layout.Rect.Min.Y + max(64, cnt.layout.Rect.Dy()+c.mouseDelta.Y)
+		hasClose := (^opt&optionNoTitle) != 0 && (^opt&optionNoClose) != 0
+		for _, h := range windowResizeHandles {
+			r := c.resizeHandleRect(rect, h.dir)
+			// keep the top handles off the close button
+			if hasClose && h.dir.Y < 0 {
+				if h.dir.X > 0 {
+					continue
+				}
+				r.Max.X = min(r.Max.X, rect.Max.X-c.style.titleHeight)
+			}
+			id := c.idFromBytes([]byte(h.name))
+			c.updateControl(id, r, opt)
+			c.setCursor(id, h.cursor)
+			if id == c.focus && c.mouseDown == mouseLeft {
+				c.dragWindow(cnt, h.dir, options)
+			}
+		}
+	}
+	if options != nil {
+		minSize, maxSize := windowSizeLimits(options, false)
+		cnt.layout.Rect = constrainWindowSize(cnt.layout.Rect, image.Pt(1, 1), minSize, maxSize)
+		if options.KeepOnScreen {
+			cnt.layout.Rect = c.keepOnScreen(cnt.layout.Rect)
 		}
 	}
 
@@ -722,8 +1071,8 @@ func (c *Context) window(title string, idStr string, rect image.Rectangle, opt o
 		cnt.layout.Rect.Max.Y = cnt.layout.Rect.Min.Y + cnt.layout.ContentSize.Y + (cnt.layout.Rect.Dy() - r.Dy())
 	}
 
-	// close if this is a popup window and elsewhere was clicked
-	if (opt&optionPopup) != 0 && c.mousePressed != 0 && c.hoverRoot != cnt {
+	// close if this is a popup window and elsewhere was clicked, or the gamepad's B button was pressed
+	if (opt&optionPopup) != 0 && ((c.mousePressed != 0 && c.hoverRoot != cnt) || (c.gamepadPressed&gamepadB) != 0) {
 		cnt.open = false
 	}
 
@@ -739,8 +1088,12 @@ func (c *Context) OpenPopup(name string) {
 	// set as hover root so popup isn't closed in begin_window_ex()
 	c.nextHoverRoot = cnt
 	c.hoverRoot = c.nextHoverRoot
-	// position at mouse cursor, open and bring-to-front
-	cnt.layout.Rect = image.Rect(c.mousePos.X, c.mousePos.Y, c.mousePos.X+1, c.mousePos.Y+1)
+	// position at mouse cursor, or below the control focused by navigation, open and bring-to-front
+	pos := c.mousePos
+	if c.navFocus {
+		pos = image.Pt(c.lastRect.Min.X, c.lastRect.Max.Y)
+	}
+	cnt.layout.Rect = image.Rect(pos.X, pos.Y, pos.X+1, pos.Y+1)
 	cnt.open = true
 	c.bringToFront(cnt)
 }
@@ -748,7 +1101,21 @@ func (c *Context) OpenPopup(name string) {
 // Popup creates a modal popup window with the given name and callback function for rendering the content.
 func (c *Context) Popup(name string, f func(res Response, layout Layout)) {
 	opt := optionPopup | optionAutoSize | optionNoResize | optionNoScroll | optionNoTitle | optionClosed
-	c.window(name, "", image.Rectangle{}, opt, f)
+	c.window(name, "", image.Rectangle{}, opt, nil, f)
+}
+
+// ContextMenu opens a popup menu with the given name at the mouse cursor when the previous control is right-clicked.
+// The callback renders the menu items. The menu is closed when it is left-clicked, after the click is handled.
+func (c *Context) ContextMenu(name string, f func(res Response, layout Layout)) {
+	if c.mousePressed == mouseRight && c.mouseOver(c.lastRect) {
+		c.OpenPopup(name)
+	}
+	c.Popup(name, func(res Response, layout Layout) {
+		f(res, layout)
+		if c.mousePressed == mouseLeft && c.hoverRoot == c.currentContainer() {
+			c.currentContainer().open = false
+		}
+	})
 }
 
 // panel sets up and manages a UI panel with the given layout, applying options and rendering content through a callback.
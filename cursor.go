@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// setCursor requests the cursor shape for the current frame while the control id is hovered or dragged.
+func (c *Context) setCursor(id controlID, shape ebiten.CursorShapeType) {
+	if id == 0 {
+		return
+	}
+	if c.hover == id || (c.focus == id && c.mouseDown != 0) {
+		c.nextCursor = shape
+	}
+}
+
+// updateCursor determines the cursor shape requested in the current frame,
+// and whether the pointer is over the UI so that the cursor is controlled by debugui.
+func (c *Context) updateCursor() {
+	c.cursorShape = c.nextCursor
+	c.cursorOverUI = c.nextHoverRoot != nil || c.nextCursor != ebiten.CursorShapeDefault
+	c.nextCursor = ebiten.CursorShapeDefault
+}
+
+// resolveCursor returns the next cursor state, the cursor shape to set and whether it has to be set.
+// current is the current cursor shape, wanted is the shape requested by the UI, and overUI reports whether the pointer
+// is over the UI. The wanted shape is used while the pointer is over the UI. The shape set by the game is remembered
+// when the pointer enters the UI, and restored when it leaves the UI.
+func resolveCursor(st cursorState, current, wanted ebiten.CursorShapeType, overUI bool) (cursorState, ebiten.CursorShapeType, bool) {
+	if !overUI {
+		if !st.owned {
+			return st, current, false
+		}
+		return cursorState{}, st.game, true
+	}
+	if !st.owned {
+		st = cursorState{
+			owned: true,
+			game:  current,
+		}
+	}
+	return st, wanted, current != wanted
+}
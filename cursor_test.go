@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"testing"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestResolveCursor(t *testing.T) {
+	game := ebiten.CursorShapeCrosshair
+	owned := cursorState{owned: true, game: game}
+
+	testCases := []struct {
+		name      string
+		st        cursorState
+		current   ebiten.CursorShapeType
+		wanted    ebiten.CursorShapeType
+		overUI    bool
+		wantState cursorState
+		wantShape ebiten.CursorShapeType
+		wantSet   bool
+	}{
+		{
+			name:      "outside the UI",
+			current:   game,
+			wantShape: game,
+		},
+		{
+			name:      "enter the UI",
+			current:   game,
+			wanted:    ebiten.CursorShapePointer,
+			overUI:    true,
+			wantState: owned,
+			wantShape: ebiten.CursorShapePointer,
+			wantSet:   true,
+		},
+		{
+			name:      "keep the shape",
+			st:        owned,
+			current:   ebiten.CursorShapePointer,
+			wanted:    ebiten.CursorShapePointer,
+			overUI:    true,
+			wantState: owned,
+			wantShape: ebiten.CursorShapePointer,
+		},
+		{
+			name:      "change the shape",
+			st:        owned,
+			current:   ebiten.CursorShapePointer,
+			wanted:    ebiten.CursorShapeText,
+			overUI:    true,
+			wantState: owned,
+			wantShape: ebiten.CursorShapeText,
+			wantSet:   true,
+		},
+		{
+			name:      "leave the UI",
+			st:        owned,
+			current:   ebiten.CursorShapeText,
+			wantShape: game,
+			wantSet:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, shape, set := resolveCursor(tc.st, tc.current, tc.wanted, tc.overUI)
+			if st != tc.wantState || shape != tc.wantShape || set != tc.wantSet {
+				t.Errorf("resolveCursor() = (%+v, %v, %v), want (%+v, %v, %v)", st, shape, set, tc.wantState, tc.wantShape, tc.wantSet)
+			}
+		})
+	}
+}
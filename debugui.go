@@ -3,24 +3,56 @@
 
 package debugui
 
-import "github.com/hajimehoshi/ebiten/v2"
+import (
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
 
 type DebugUI struct {
 	ctx *Context
 }
 
 func New() *DebugUI {
+	style := defaultStyle
 	return &DebugUI{
 		ctx: &Context{
-			style: &defaultStyle,
+			style:        &style,
+			clipboard:    &memoryClipboard{},
+			tooltipDelay: defaultTooltipDelay,
 		},
 	}
 }
 
+// SetClipboard sets the clipboard used by text boxes for copy, cut and paste.
+// If clipboard is nil, an in-memory clipboard is used, which is the default.
+func (d *DebugUI) SetClipboard(clipboard Clipboard) {
+	if clipboard == nil {
+		clipboard = &memoryClipboard{}
+	}
+	d.ctx.clipboard = clipboard
+}
+
+// SetTouchSlop sets the distance in pixels by which controls are extended for hit testing touches.
+// A touch moving farther than the slop is not a long press.
+func (d *DebugUI) SetTouchSlop(slop int) {
+	d.ctx.style.touchSlop = max(slop, 0)
+}
+
+// SetTooltipDelay sets the number of ticks the mouse has to hover a control before its tooltip appears.
+func (d *DebugUI) SetTooltipDelay(ticks int) {
+	d.ctx.tooltipDelay = max(ticks, 0)
+}
+
 func (d *DebugUI) Update(f func(ctx *Context)) {
+	start := time.Now()
 	d.ctx.update(f)
+	d.ctx.applyCursor()
+	d.ctx.updateDuration = time.Since(start)
 }
 
 func (d *DebugUI) Draw(screen *ebiten.Image) {
+	start := time.Now()
 	d.ctx.draw(screen)
+	d.ctx.drawDuration = time.Since(start)
 }
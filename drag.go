@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+)
+
+const dragPreviewName = "!drag"
+
+// DragSource makes the previous control a source of drag-and-drop.
+// Dragging the control with the left mouse button carries payload of the type payloadType,
+// which is received by DropTarget of the same type. The payload is shown next to the mouse cursor while dragging.
+func (c *Context) DragSource(payloadType string, payload any) {
+	if c.dragging || c.mousePressed != mouseLeft || !c.mouseOver(c.lastRect) {
+		return
+	}
+	c.dragPending = true
+	c.dragStart = c.mousePos
+	c.dragPayloadType = payloadType
+	c.dragPayload = payload
+}
+
+// DropTarget makes the previous control a target of drag-and-drop.
+// The control is highlighted while a payload of the type payloadType is dragged over it.
+// DropTarget returns the payload and true when the payload is dropped on the control.
+func (c *Context) DropTarget(payloadType string) (any, bool) {
+	if !c.dragging || c.dragPayloadType != payloadType || !c.mouseOver(c.lastRect) {
+		return nil, false
+	}
+	if (c.mouseDown & mouseLeft) != 0 {
+		c.drawBox(c.lastRect, c.style.colors[ColorFocusRing])
+		return nil, false
+	}
+	payload := c.dragPayload
+	c.endDrag()
+	return payload, true
+}
+
+// updateDrag starts dragging once the mouse moves far enough from where a drag source was pressed,
+// and ends dragging when the left mouse button is released.
+func (c *Context) updateDrag() {
+	if (c.mouseDown & mouseLeft) == 0 {
+		c.endDrag()
+		return
+	}
+	if c.dragPending && !c.dragging {
+		d := c.mousePos.Sub(c.dragStart)
+		c.dragging = d.X*d.X+d.Y*d.Y > dragThreshold*dragThreshold
+	}
+}
+
+func (c *Context) endDrag() {
+	c.dragPending = false
+	c.dragging = false
+	c.dragPayloadType = ""
+	c.dragPayload = nil
+}
+
+// dragPreview shows the dragged payload in a popup next to the mouse cursor.
+func (c *Context) dragPreview() {
+	if !c.dragging {
+		return
+	}
+
+	label := fmt.Sprint(c.dragPayload)
+	c.floatingWindow(dragPreviewName, c.mousePos.Add(image.Pt(tooltipOffset, tooltipOffset)), func() {
+		c.SetLayoutRow([]int{textWidth(label)}, lineHeight())
+		c.control(0, 0, func(r image.Rectangle) Response {
+			c.drawText(label, r.Min, c.style.colors[ColorText])
+			return 0
+		})
+	})
+}
@@ -15,6 +15,7 @@ const (
 	commandText
 	commandIcon
 	commandDraw
+	commandImage
 )
 
 const (
@@ -32,7 +33,9 @@ const (
 	ColorBaseFocus
 	ColorScrollBase
 	ColorScrollThumb
-	ColorMax = ColorScrollThumb
+	ColorInvalid
+	ColorFocusRing
+	ColorMax = ColorFocusRing
 )
 
 type icon int
@@ -68,6 +71,9 @@ const (
 	optionPopup
 	optionClosed
 	optionExpanded
+	optionPassword
+	optionReadOnly
+	optionNoUndo
 )
 
 const (
@@ -82,4 +88,49 @@ const (
 	keyAlt       = (1 << 2)
 	keyBackspace = (1 << 3)
 	keyReturn    = (1 << 4)
+	keyLeft      = (1 << 5)
+	keyRight     = (1 << 6)
+	keyUp        = (1 << 7)
+	keyDown      = (1 << 8)
+	keyHome      = (1 << 9)
+	keyEnd       = (1 << 10)
+	keyDelete    = (1 << 11)
+	keyA         = (1 << 12)
+	keyC         = (1 << 13)
+	keyV         = (1 << 14)
+	keyX         = (1 << 15)
+	keyY         = (1 << 16)
+	keyZ         = (1 << 17)
+	keyTab       = (1 << 18)
+	keySpace     = (1 << 19)
+	keyPageUp    = (1 << 20)
+	keyPageDown  = (1 << 21)
+	keyEscape    = (1 << 22)
+	keyF1        = (1 << 23)
+	keyF2        = (1 << 24)
+	keyF3        = (1 << 25)
+	keyF4        = (1 << 26)
+	keyF5        = (1 << 27)
+	keyF6        = (1 << 28)
+	keyF7        = (1 << 29)
+	keyF8        = (1 << 30)
+	keyF9        = (1 << 31)
+	keyF10       = (1 << 32)
+	keyF11       = (1 << 33)
+	keyF12       = (1 << 34)
+	keyP         = (1 << 35)
+
+	// keyModifiers are the keys that are not repeated while held.
+	keyModifiers = keyShift | keyControl | keyAlt
+)
+
+const (
+	gamepadUp    = (1 << 0)
+	gamepadDown  = (1 << 1)
+	gamepadLeft  = (1 << 2)
+	gamepadRight = (1 << 3)
+	gamepadA     = (1 << 4)
+	gamepadB     = (1 << 5)
+	gamepadLB    = (1 << 6)
+	gamepadRB    = (1 << 7)
 )
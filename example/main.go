@@ -4,6 +4,7 @@
 package main
 
 import (
+	"image"
 	"log"
 
 	"github.com/hajimehoshi/ebiten/v2"
@@ -21,25 +22,85 @@ type Game struct {
 	checks       [3]bool
 	num1         float64
 	num2         float64
+	vec3         [3]float64
+	script       string
+	tps          *debugui.RingBuffer
+
+	tableSortColumn int
+	tableAscending  bool
+
+	player player
+
+	dragItems []string
+
+	toggleDemo bool
+	quit       bool
+}
+
+type player struct {
+	Name     string
+	HP       int     `debugui:"min=0,max=100"`
+	Speed    float64 `debugui:"step=0.1,digits=1"`
+	Visible  bool
+	Position [2]float64
+	Items    []string
+	Stats    map[string]int
+	ID       int `debugui:"readonly"`
 }
 
 func New() *Game {
-	return &Game{
+	g := &Game{
 		debugUI: debugui.New(),
 		bg:      [3]float64{90, 95, 100},
 		checks:  [3]bool{true, false, true},
+		script:  "{\n  \"name\": \"debugui\"\n}",
+		tps:     debugui.NewRingBuffer(120),
+		player: player{
+			Name:    "Hero",
+			HP:      80,
+			Speed:   1.5,
+			Visible: true,
+			Items:   []string{"Sword", "Shield"},
+			Stats:   map[string]int{"STR": 12, "DEX": 9},
+			ID:      1,
+		},
+		dragItems: []string{"Apple", "Banana", "Cherry", "Durian"},
+	}
+	if err := g.debugUI.RegisterShortcut("ctrl+shift+D", "Toggle the demo window", func() {
+		g.toggleDemo = true
+	}); err != nil {
+		panic(err)
+	}
+	// Escape is used to close the command palette, so quit by ctrl+Q instead
+	if err := g.debugUI.RegisterShortcut("ctrl+Q", "Quit the demo", func() {
+		g.quit = true
+	}); err != nil {
+		panic(err)
 	}
+	g.debugUI.RegisterCommand("Toggle Demo Window", "Window", func() {
+		g.toggleDemo = true
+	})
+	g.debugUI.RegisterCommand("Clear", "Log", func() {
+		g.logBuf = ""
+	})
+	g.debugUI.RegisterCommand("Reset Background", "Style", func() {
+		g.bg = [3]float64{90, 95, 100}
+	})
+	return g
 }
 
 func (g *Game) Update() error {
-	if ebiten.IsKeyPressed(ebiten.KeyEscape) {
-		return ebiten.Termination
-	}
+	g.tps.Push(ebiten.ActualTPS())
 	g.debugUI.Update(func(ctx *debugui.Context) {
 		g.testWindow(ctx)
 		g.logWindow(ctx)
 		g.buttonWindows(ctx)
+		ctx.UndoWindow(image.Rect(660, 40, 900, 290))
+		ctx.ShortcutWindow(image.Rect(660, 300, 900, 400))
 	})
+	if g.quit {
+		return ebiten.Termination
+	}
 	return nil
 }
 
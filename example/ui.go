@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"image"
 	"image/color"
+	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/text/v2"
@@ -25,11 +26,10 @@ func (g *Game) writeLog(text string) {
 
 func (g *Game) testWindow(ctx *debugui.Context) {
 	const demoWindow = "Demo Window"
-	if !ctx.WindowContainer(demoWindow).IsOpen() {
-		if ebiten.IsKeyPressed(ebiten.KeyTab) {
-			ctx.WindowContainer(demoWindow).SetOpen(true)
-		}
-
+	if g.toggleDemo {
+		g.toggleDemo = false
+		w := ctx.WindowContainer(demoWindow)
+		w.SetOpen(!w.IsOpen())
 	}
 	ctx.Window(demoWindow, image.Rect(40, 40, 340, 500), func(res debugui.Response, layout debugui.Layout) {
 		// window info
@@ -58,6 +58,7 @@ func (g *Game) testWindow(ctx *debugui.Context) {
 			if ctx.Button("Popup") != 0 {
 				ctx.OpenPopup("Test Popup")
 			}
+			ctx.Tooltip("Opens a popup at the mouse cursor")
 			ctx.Popup("Test Popup", func(res debugui.Response, layout debugui.Layout) {
 				ctx.Button("Hello")
 				ctx.Button("World")
@@ -149,12 +150,87 @@ func (g *Game) testWindow(ctx *debugui.Context) {
 			ctx.SetLayoutRow([]int{-1}, 0)
 			ctx.Number(&g.num1, 0.1, 2)
 			ctx.Slider(&g.num2, 0, 10, 0.1, 2)
+			ctx.ContextMenu("Slider Menu", func(res debugui.Response, layout debugui.Layout) {
+				ctx.SetLayoutRow([]int{120}, 0)
+				if ctx.Button("Reset to default") != 0 {
+					g.num2 = 0
+				}
+				if ctx.Button("Log value") != 0 {
+					g.writeLog(fmt.Sprintf("Slider value: %.2f", g.num2))
+				}
+			})
+			ctx.Vec3(&g.vec3, 0.1, 2)
+			ctx.ProgressBar(g.num2/10, fmt.Sprintf("%.0f%%", g.num2*10))
+		}
+
+		// Plot
+		if ctx.Header("Plot", false) != 0 {
+			ctx.SetLayoutRow([]int{-1}, 60)
+			ctx.PlotLines("TPS", g.tps.Values(), nil)
+		}
+
+		// TextArea
+		if ctx.Header("Text Area", false) != 0 {
+			ctx.SetLayoutRow([]int{-1}, 0)
+			ctx.TextArea(&g.script, 80)
+		}
+
+		// Inspect
+		if ctx.Header("Inspect", false) != 0 {
+			ctx.Inspect("Player", &g.player)
+		}
+
+		// Table
+		if ctx.Header("Table", false) != 0 {
+			const n = 100
+			columns := []debugui.TableColumn{{Label: "N", Width: 40}, {Label: "Square"}, {Label: "Parity"}}
+			ctx.SetLayoutRow([]int{-1}, 120)
+			g.tableSortColumn, g.tableAscending = ctx.Table("Squares", columns, n, func(row, col int) {
+				// the rows are always ordered by N, so a descending sort just reverses them
+				if g.tableSortColumn >= 0 && !g.tableAscending {
+					row = n - 1 - row
+				}
+				switch col {
+				case 0:
+					ctx.Label(fmt.Sprintf("%d", row))
+				case 1:
+					ctx.Label(fmt.Sprintf("%d", row*row))
+				case 2:
+					if row%2 == 0 {
+						ctx.Label("even")
+					} else {
+						ctx.Label("odd")
+					}
+				}
+			})
+		}
+
+		// Drag and Drop
+		if ctx.Header("Drag and Drop", false) != 0 {
+			ctx.SetLayoutRow([]int{-1}, 0)
+			from, to := -1, -1
+			for i, item := range g.dragItems {
+				ctx.Label(item)
+				ctx.DragSource("item", item)
+				if p, ok := ctx.DropTarget("item"); ok {
+					from, to = slices.Index(g.dragItems, p.(string)), i
+				}
+			}
+			if from >= 0 && from != to {
+				item := g.dragItems[from]
+				g.dragItems = slices.Insert(slices.Delete(g.dragItems, from, from+1), to, item)
+			}
 		}
 	})
 }
 
 func (g *Game) logWindow(ctx *debugui.Context) {
-	ctx.Window("Log Window", image.Rect(350, 40, 650, 290), func(res debugui.Response, layout debugui.Layout) {
+	opts := &debugui.WindowOptions{
+		MinSize:      image.Pt(200, 120),
+		Snap:         true,
+		KeepOnScreen: true,
+	}
+	ctx.WindowWithOptions("Log Window", image.Rect(350, 40, 650, 290), opts, func(res debugui.Response, layout debugui.Layout) {
 		// output text panel
 		ctx.SetLayoutRow([]int{-1}, -25)
 		ctx.Panel("Log Output", func(layout debugui.Layout) {
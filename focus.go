@@ -0,0 +1,218 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"image"
+	"sort"
+)
+
+// setNavFocus sets the focus to id by the keyboard or a gamepad.
+// A control focused by navigation keeps the focus without the mouse button held, and is drawn with a focus ring.
+func (c *Context) setNavFocus(id controlID) {
+	c.setFocus(id)
+	c.navFocus = id != 0
+	c.scrollToFocus = id != 0
+}
+
+// registerFocus appends the control id at r to the focus order of the current frame.
+func (c *Context) registerFocus(id controlID, r image.Rectangle) {
+	if id == 0 {
+		return
+	}
+	if n := len(c.focusOrder); n > 0 && c.focusOrder[n-1].id == id {
+		return
+	}
+	e := focusEntry{
+		id:   id,
+		rect: r,
+		root: c.rootContainer,
+	}
+	if len(c.containerStack) > 0 {
+		e.cnt = c.currentContainer()
+	}
+	c.focusOrder = append(c.focusOrder, e)
+}
+
+// focusIndex returns the index of the control id in the focus order, or -1 if it is not found.
+func (c *Context) focusIndex(id controlID) int {
+	for i, e := range c.focusOrder {
+		if e.id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// handleFocusKeys moves the focus to the next control in the submission order by Tab,
+// or to the previous one by Shift+Tab.
+func (c *Context) handleFocusKeys() {
+	if (c.keyPressed&keyTab) == 0 || len(c.focusOrder) == 0 {
+		return
+	}
+	idx := c.focusIndex(c.focus)
+	n := len(c.focusOrder)
+	if (c.keyDown & keyShift) != 0 {
+		if idx < 0 {
+			idx = 0
+		}
+		idx = (idx - 1 + n) % n
+	} else {
+		idx = (idx + 1) % n
+	}
+	c.setNavFocus(c.focusOrder[idx].id)
+}
+
+// handleGamepad moves the focus by the D-pad and cycles the windows by the shoulder buttons.
+func (c *Context) handleGamepad() {
+	switch {
+	case (c.gamepadPressed & gamepadUp) != 0:
+		c.moveFocus(image.Pt(0, -1))
+	case (c.gamepadPressed & gamepadDown) != 0:
+		c.moveFocus(image.Pt(0, 1))
+	case (c.gamepadPressed & gamepadLeft) != 0:
+		c.moveFocus(image.Pt(-1, 0))
+	case (c.gamepadPressed & gamepadRight) != 0:
+		c.moveFocus(image.Pt(1, 0))
+	}
+	switch {
+	case (c.gamepadPressed & gamepadLB) != 0:
+		c.cycleWindows(-1)
+	case (c.gamepadPressed & gamepadRB) != 0:
+		c.cycleWindows(1)
+	}
+}
+
+// moveFocus moves the focus to the nearest control in the direction dir from the focused control in the same window.
+// Controls roughly in line with the focused control are preferred to closer ones off to the side.
+// If no control is focused, the first control of the frontmost window is focused.
+func (c *Context) moveFocus(dir image.Point) {
+	idx := c.focusIndex(c.focus)
+	if idx < 0 {
+		first := -1
+		for i, e := range c.focusOrder {
+			if e.root == nil {
+				continue
+			}
+			if first < 0 || e.root.zIndex > c.focusOrder[first].root.zIndex {
+				first = i
+			}
+		}
+		if first >= 0 {
+			c.setNavFocus(c.focusOrder[first].id)
+		}
+		return
+	}
+
+	center := func(r image.Rectangle) image.Point {
+		return r.Min.Add(r.Max).Div(2)
+	}
+	p := center(c.focusOrder[idx].rect)
+	best := -1
+	var bestScore int
+	for i, e := range c.focusOrder {
+		if i == idx || e.root != c.focusOrder[idx].root {
+			continue
+		}
+		d := center(e.rect).Sub(p)
+		along := d.X*dir.X + d.Y*dir.Y
+		if along <= 0 {
+			continue
+		}
+		across := d.X*dir.Y - d.Y*dir.X
+		if across < 0 {
+			across = -across
+		}
+		if score := along + 2*across; best < 0 || score < bestScore {
+			best, bestScore = i, score
+		}
+	}
+	if best >= 0 {
+		c.setNavFocus(c.focusOrder[best].id)
+	}
+}
+
+// cycleWindows brings the backmost window to the front if d is positive, or sends the frontmost window to the back
+// otherwise by bringing all the other windows to the front in order. The focus moves to the first control of the new
+// frontmost window.
+func (c *Context) cycleWindows(d int) {
+	var roots []*container
+	for _, cnt := range c.rootList {
+		if cnt.open {
+			roots = append(roots, cnt)
+		}
+	}
+	if len(roots) < 2 {
+		return
+	}
+	sort.Slice(roots, func(i, j int) bool {
+		return roots[i].zIndex < roots[j].zIndex
+	})
+
+	var front *container
+	if d > 0 {
+		front = roots[0]
+		c.bringToFront(front)
+	} else {
+		front = roots[len(roots)-2]
+		for _, cnt := range roots[:len(roots)-1] {
+			c.bringToFront(cnt)
+		}
+	}
+
+	for _, e := range c.focusOrder {
+		if e.root == front {
+			c.setNavFocus(e.id)
+			return
+		}
+	}
+	c.setFocus(0)
+}
+
+// navActivated reports whether the control id is focused by navigation and Space, Enter or the gamepad's A button was
+// pressed.
+func (c *Context) navActivated(id controlID) bool {
+	if !c.navFocus || c.focus != id {
+		return false
+	}
+	return (c.keyPressed&(keySpace|keyReturn)) != 0 || (c.gamepadPressed&gamepadA) != 0
+}
+
+// keyStep returns -1 or 1 if the control id has the keyboard focus and an arrow key to decrease or increase its value
+// was pressed, or 0 otherwise.
+func (c *Context) keyStep(id controlID) float64 {
+	if !c.navFocus || c.focus != id {
+		return 0
+	}
+	switch {
+	case (c.keyPressed & (keyLeft | keyDown)) != 0:
+		return -1
+	case (c.keyPressed & (keyRight | keyUp)) != 0:
+		return 1
+	}
+	return 0
+}
+
+// drawFocusRing draws the focus ring around r if the control id is focused by navigation.
+// The current container is scrolled so that a newly focused control becomes visible.
+func (c *Context) drawFocusRing(id controlID, r image.Rectangle) {
+	if !c.navFocus || c.focus != id {
+		return
+	}
+	c.drawBox(r.Inset(-2), c.style.colors[ColorFocusRing])
+
+	if !c.scrollToFocus || len(c.containerStack) == 0 {
+		return
+	}
+	c.scrollToFocus = false
+	cnt := c.currentContainer()
+	body := cnt.layout.Body
+	switch {
+	case r.Min.Y < body.Min.Y:
+		cnt.layout.Scroll.Y -= body.Min.Y - r.Min.Y + c.style.padding
+	case r.Max.Y > body.Max.Y:
+		cnt.layout.Scroll.Y += r.Max.Y - body.Max.Y + c.style.padding
+	}
+	cnt.layout.Scroll.Y = max(cnt.layout.Scroll.Y, 0)
+}
@@ -6,6 +6,9 @@ package debugui
 import (
 	"image"
 	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 	"unsafe"
 )
 
@@ -17,6 +20,130 @@ func clampF(x, a, b float64) float64 {
 	return min(b, max(a, x))
 }
 
+// wrapLines splits str into visual lines no wider than width, breaking at spaces and newlines.
+// A word wider than width is kept on its own line. There is always at least one line.
+func wrapLines(str string, width int) []textLine {
+	var lines []textLine
+	start := 0
+	for {
+		hardEnd := len(str)
+		if i := strings.IndexByte(str[start:], '\n'); i >= 0 {
+			hardEnd = start + i
+		}
+
+		end := hardEnd
+		if textWidth(str[start:hardEnd]) > width {
+			// break at the last space that fits, or the first space if no space fits
+			end = -1
+			for i := start; i < hardEnd; i++ {
+				if str[i] != ' ' {
+					continue
+				}
+				if end >= 0 && textWidth(str[start:i]) > width {
+					break
+				}
+				end = i
+			}
+			if end < 0 {
+				end = hardEnd
+			}
+		}
+
+		next := end + 1
+		if end == hardEnd && hardEnd == len(str) {
+			lines = append(lines, textLine{start: start, end: end, next: end})
+			return lines
+		}
+		lines = append(lines, textLine{start: start, end: end, next: next})
+		start = next
+	}
+}
+
+// lineAt returns the index of the line containing the byte offset p.
+func lineAt(lines []textLine, p int) int {
+	for i := 0; i < len(lines)-1; i++ {
+		if p < lines[i].next {
+			return i
+		}
+	}
+	return len(lines) - 1
+}
+
+// offsetAtX returns the byte offset in the line ln of str whose caret position is the nearest to x.
+func offsetAtX(str string, ln textLine, x int) int {
+	p := ln.start
+	for p < ln.end {
+		_, size := utf8.DecodeRuneInString(str[p:])
+		w0 := textWidth(str[ln.start:p])
+		w1 := textWidth(str[ln.start : p+size])
+		if x < (w0+w1)/2 {
+			return p
+		}
+		p += size
+	}
+	return ln.end
+}
+
+// filterText returns str without the runes for which filter returns false.
+func filterText(str string, filter func(r rune) bool) string {
+	return strings.Map(func(r rune) rune {
+		if !filter(r) {
+			return -1
+		}
+		return r
+	}, str)
+}
+
+// filterInserted filters the part of text that was inserted into old, keeping the rest as it is.
+// It returns the filtered text and the byte offset at the end of the filtered insertion.
+func filterInserted(old, text string, filter func(r rune) bool) (string, int) {
+	// find the common prefix and suffix at rune boundaries
+	var prefix int
+	for prefix < len(old) && prefix < len(text) && old[prefix] == text[prefix] {
+		prefix++
+	}
+	for prefix > 0 && prefix < len(text) && !utf8.RuneStart(text[prefix]) {
+		prefix--
+	}
+	var suffix int
+	for suffix < len(old)-prefix && suffix < len(text)-prefix && old[len(old)-suffix-1] == text[len(text)-suffix-1] {
+		suffix++
+	}
+	for suffix > 0 && !utf8.RuneStart(text[len(text)-suffix]) {
+		suffix--
+	}
+	inserted := filterText(text[prefix:len(text)-suffix], filter)
+	return text[:prefix] + inserted + text[len(text)-suffix:], prefix + len(inserted)
+}
+
+// wordBounds returns the byte range of the word in str around the byte offset p.
+// A word is a run of letters, digits and underscores. If p is not in a word, the rune at p is returned.
+func wordBounds(str string, p int) (start, end int) {
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+	}
+	start, end = p, p
+	for start > 0 {
+		r, size := utf8.DecodeLastRuneInString(str[:start])
+		if !isWord(r) {
+			break
+		}
+		start -= size
+	}
+	for end < len(str) {
+		r, size := utf8.DecodeRuneInString(str[end:])
+		if !isWord(r) {
+			break
+		}
+		end += size
+	}
+	if start == end && end < len(str) {
+		_, size := utf8.DecodeRuneInString(str[end:])
+		end += size
+	}
+	return start, end
+}
+
 func fnv1a(init controlID, data []byte) controlID {
 	h := init
 	for i := 0; i < len(data); i++ {
@@ -151,20 +278,29 @@ func (c *Context) SetFocus() {
 func (c *Context) setFocus(id controlID) {
 	c.focus = id
 	c.keepFocus = true
+	c.navFocus = false
+	// a new interaction starts a new undo entry
+	c.undoSealed = true
 }
 
 func (c *Context) update(f func(ctx *Context)) {
 	c.begin()
 	defer c.end()
 	f(c)
+	c.commandPalette()
+	c.dragPreview()
 }
 
 func (c *Context) begin() {
 	c.updateInput()
+	c.handleShortcuts()
+	c.textEditing = false
 
 	c.commandList = c.commandList[:0]
+	c.prevRootList = append(c.prevRootList[:0], c.rootList...)
 	c.rootList = c.rootList[:0]
 	c.scrollTarget = nil
+	c.focusOrder = c.focusOrder[:0]
 	c.hoverRoot = c.nextHoverRoot
 	c.nextHoverRoot = nil
 	c.mouseDelta.X = c.mousePos.X - c.lastMousePos.X
@@ -187,18 +323,40 @@ func (c *Context) end() {
 		panic("layout stack not empty")
 	}
 
+	// scroll the container of the control focused by navigation if no container is hovered
+	if c.scrollTarget == nil && c.navFocus {
+		if idx := c.focusIndex(c.focus); idx >= 0 {
+			c.scrollTarget = c.focusOrder[idx].cnt
+		}
+	}
+
 	// handle scroll input
 	if c.scrollTarget != nil {
 		c.scrollTarget.layout.Scroll.X += c.scrollDelta.X
 		c.scrollTarget.layout.Scroll.Y += c.scrollDelta.Y
 	}
 
+	// handle undo and redo shortcuts
+	c.handleUndoKeys()
+	c.handlePaletteKeys()
+
 	// unset focus if focus id was not touched this frame
 	if !c.keepFocus {
 		c.focus = 0
+		c.navFocus = false
+		c.undoSealed = true
 	}
 	c.keepFocus = false
 
+	// start or finish drag-and-drop
+	c.updateDrag()
+
+	c.pruneInspectProxies()
+
+	// handle focus traversal
+	c.handleFocusKeys()
+	c.handleGamepad()
+
 	// bring hover root to front if mouse was pressed
 	if c.mousePressed != 0 && c.nextHoverRoot != nil &&
 		c.nextHoverRoot.zIndex < c.lastZIndex &&
@@ -206,8 +364,15 @@ func (c *Context) end() {
 		c.bringToFront(c.nextHoverRoot)
 	}
 
+	c.updateCursor()
+
+	c.lastCommandCount = len(c.commandList)
+
 	// reset input state
 	c.keyPressed = 0
+	clear(c.keysPressed[:])
+	c.gamepadPressed = 0
+	c.shortcutKeys = c.shortcutKeys[:0]
 	c.mousePressed = 0
 	c.scrollDelta = image.Pt(0, 0)
 	c.lastMousePos = c.mousePos
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"image"
+	"image/color"
+	"unsafe"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// imageRect returns the rectangle where an image of the given size is drawn in r with the given mode.
+func imageRect(size image.Point, r image.Rectangle, mode ImageMode) image.Rectangle {
+	if size.X <= 0 || size.Y <= 0 {
+		return image.Rectangle{}
+	}
+	scale := 1.0
+	sx := float64(r.Dx()) / float64(size.X)
+	sy := float64(r.Dy()) / float64(size.Y)
+	switch mode {
+	case ImageModeFit:
+		scale = min(sx, sy)
+	case ImageModeFill:
+		scale = max(sx, sy)
+	}
+	w := int(float64(size.X) * scale)
+	h := int(float64(size.Y) * scale)
+	x := r.Min.X + (r.Dx()-w)/2
+	y := r.Min.Y + (r.Dy()-h)/2
+	return image.Rect(x, y, x+w, y+h)
+}
+
+// drawImageInRect draws img in r according to the options, clipped to r.
+func (c *Context) drawImageInRect(img *ebiten.Image, r image.Rectangle, options *ImageOptions) {
+	var op ImageOptions
+	if options != nil {
+		op = *options
+	}
+	if !op.Source.Empty() {
+		img = img.SubImage(op.Source).(*ebiten.Image)
+	}
+	var clr color.Color = color.White
+	if op.Tint != nil {
+		clr = op.Tint
+	}
+	c.pushClipRect(r)
+	c.drawImage(img, imageRect(img.Bounds().Size(), r, op.Mode), clr)
+	c.popClipRect()
+}
+
+// image renders img in the next layout cell according to the options.
+func (c *Context) image(img *ebiten.Image, options *ImageOptions, opt option) {
+	c.control(0, opt, func(r image.Rectangle) Response {
+		c.drawImageInRect(img, r, options)
+		return 0
+	})
+}
+
+// imageButton renders a button showing img inside its frame and returns the interaction response.
+// The button is identified by the image, so an image should be used for one button at a time.
+func (c *Context) imageButton(img *ebiten.Image, options *ImageOptions, opt option) Response {
+	id := c.pushID(ptrToBytes(unsafe.Pointer(img)))
+	defer c.popID()
+
+	return c.control(id, opt, func(r image.Rectangle) Response {
+		var res Response
+		// handle click
+		if (c.mousePressed == mouseLeft && c.focus == id) || c.navActivated(id) {
+			res |= ResponseSubmit
+		}
+		c.setCursor(id, ebiten.CursorShapePointer)
+		// draw
+		c.drawControlFrame(id, r, ColorButton, opt)
+		c.drawImageInRect(img, r.Inset(c.style.padding), options)
+		return res
+	})
+}
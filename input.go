@@ -5,6 +5,7 @@ package debugui
 
 import (
 	"image"
+	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -27,6 +28,16 @@ func mouseButtonToInt(btn ebiten.MouseButton) int {
 
 func (c *Context) inputMouseDown(x, y int, btn ebiten.MouseButton) {
 	c.inputMouseMove(x, y)
+	if btn == ebiten.MouseButtonLeft {
+		// count successive clicks at the same position for double-clicks
+		if c.tick-c.lastClickTick <= doubleClickTicks && c.mousePos == c.lastClickPos {
+			c.clickCount++
+		} else {
+			c.clickCount = 1
+		}
+		c.lastClickTick = c.tick
+		c.lastClickPos = c.mousePos
+	}
 	c.mouseDown |= mouseButtonToInt(btn)
 	c.mousePressed |= mouseButtonToInt(btn)
 }
@@ -41,7 +52,7 @@ func (c *Context) inputScroll(x, y int) {
 	c.scrollDelta.Y += y
 }
 
-func keyToInt(key ebiten.Key) int {
+func keyToInt(key ebiten.Key) uint64 {
 	switch key {
 	case ebiten.KeyShift:
 		return keyShift
@@ -53,15 +64,250 @@ func keyToInt(key ebiten.Key) int {
 		return keyBackspace
 	case ebiten.KeyEnter:
 		return keyReturn
+	case ebiten.KeyArrowLeft:
+		return keyLeft
+	case ebiten.KeyArrowRight:
+		return keyRight
+	case ebiten.KeyArrowUp:
+		return keyUp
+	case ebiten.KeyArrowDown:
+		return keyDown
+	case ebiten.KeyHome:
+		return keyHome
+	case ebiten.KeyEnd:
+		return keyEnd
+	case ebiten.KeyDelete:
+		return keyDelete
+	case ebiten.KeyA:
+		return keyA
+	case ebiten.KeyC:
+		return keyC
+	case ebiten.KeyV:
+		return keyV
+	case ebiten.KeyX:
+		return keyX
+	case ebiten.KeyY:
+		return keyY
+	case ebiten.KeyZ:
+		return keyZ
+	case ebiten.KeyTab:
+		return keyTab
+	case ebiten.KeySpace:
+		return keySpace
+	case ebiten.KeyPageUp:
+		return keyPageUp
+	case ebiten.KeyPageDown:
+		return keyPageDown
+	case ebiten.KeyEscape:
+		return keyEscape
+	case ebiten.KeyF1:
+		return keyF1
+	case ebiten.KeyF2:
+		return keyF2
+	case ebiten.KeyF3:
+		return keyF3
+	case ebiten.KeyF4:
+		return keyF4
+	case ebiten.KeyF5:
+		return keyF5
+	case ebiten.KeyF6:
+		return keyF6
+	case ebiten.KeyF7:
+		return keyF7
+	case ebiten.KeyF8:
+		return keyF8
+	case ebiten.KeyF9:
+		return keyF9
+	case ebiten.KeyF10:
+		return keyF10
+	case ebiten.KeyF11:
+		return keyF11
+	case ebiten.KeyF12:
+		return keyF12
+	case ebiten.KeyP:
+		return keyP
 	}
 	return 0
 }
 
-func (c *Context) inputKeyDown(key ebiten.Key) {
+// isModifierKey reports whether key is a modifier key, which is not repeated.
+func isModifierKey(key ebiten.Key) bool {
+	switch key {
+	case ebiten.KeyShift, ebiten.KeyShiftLeft, ebiten.KeyShiftRight,
+		ebiten.KeyControl, ebiten.KeyControlLeft, ebiten.KeyControlRight,
+		ebiten.KeyAlt, ebiten.KeyAltLeft, ebiten.KeyAltRight,
+		ebiten.KeyMeta, ebiten.KeyMetaLeft, ebiten.KeyMetaRight:
+		return true
+	}
+	return false
+}
+
+// setKeyPressed marks key as pressed in the current frame.
+func (c *Context) setKeyPressed(key ebiten.Key) {
 	c.keyPressed |= keyToInt(key)
+	if key >= 0 && key <= ebiten.KeyMax {
+		c.keysPressed[key] = true
+	}
+}
+
+func (c *Context) inputKeyDown(key ebiten.Key) {
+	c.setKeyPressed(key)
 	c.keyDown |= keyToInt(key)
 }
 
 func (c *Context) inputKeyUp(key ebiten.Key) {
 	c.keyDown &= ^keyToInt(key)
 }
+
+// inputKeyHold handles a key held for the given number of ticks.
+// Like the key repeat of an OS, the key is pressed again after keyRepeatDelay ticks, and then every keyRepeatInterval
+// ticks. Modifier keys are not repeated.
+func (c *Context) inputKeyHold(key ebiten.Key, ticks int) {
+	if isModifierKey(key) {
+		return
+	}
+	if ticks >= keyRepeatDelay && (ticks-keyRepeatDelay)%keyRepeatInterval == 0 {
+		c.setKeyPressed(key)
+	}
+}
+
+func gamepadButtonToInt(btn ebiten.StandardGamepadButton) int {
+	switch btn {
+	case ebiten.StandardGamepadButtonLeftTop:
+		return gamepadUp
+	case ebiten.StandardGamepadButtonLeftBottom:
+		return gamepadDown
+	case ebiten.StandardGamepadButtonLeftLeft:
+		return gamepadLeft
+	case ebiten.StandardGamepadButtonLeftRight:
+		return gamepadRight
+	case ebiten.StandardGamepadButtonRightBottom:
+		return gamepadA
+	case ebiten.StandardGamepadButtonRightRight:
+		return gamepadB
+	case ebiten.StandardGamepadButtonFrontTopLeft:
+		return gamepadLB
+	case ebiten.StandardGamepadButtonFrontTopRight:
+		return gamepadRB
+	}
+	return 0
+}
+
+func (c *Context) inputGamepadButtonPress(btn ebiten.StandardGamepadButton) {
+	c.gamepadPressed |= gamepadButtonToInt(btn)
+}
+
+// touchCenter returns the center of the touches.
+func (c *Context) touchCenter() image.Point {
+	var p image.Point
+	for _, t := range c.touches {
+		p = p.Add(t)
+	}
+	return p.Div(max(len(c.touches), 1))
+}
+
+// inputTouchDown handles a new touch at (x, y).
+// The first touch moves the pointer, and the left button is held back until the touch is released or moves past the
+// touch slop, so that a long press doesn't activate the touched control. A second touch cancels the press and starts a
+// two-finger scroll.
+func (c *Context) inputTouchDown(id ebiten.TouchID, x, y int) {
+	if c.touches == nil {
+		c.touches = make(map[ebiten.TouchID]image.Point)
+	}
+	c.touches[id] = image.Pt(x, y)
+	c.touchInput = true
+
+	switch len(c.touches) {
+	case 1:
+		c.primaryTouch = id
+		c.touchStart = image.Pt(x, y)
+		c.touchStartTick = c.tick
+		c.touchPressPending = true
+		c.touchHoldDone = false
+		c.inputMouseMove(x, y)
+	case 2:
+		c.touchPressPending = false
+		c.touchHoldDone = true
+		if (c.mouseDown & mouseLeft) != 0 {
+			c.inputMouseUp(c.mousePos.X, c.mousePos.Y, ebiten.MouseButtonLeft)
+		}
+		p := c.touchCenter()
+		c.inputMouseMove(p.X, p.Y)
+	}
+}
+
+// inputTouchMove handles a move of a touch to (x, y).
+// The primary touch moves the pointer, and two touches scroll the container under their center.
+func (c *Context) inputTouchMove(id ebiten.TouchID, x, y int) {
+	if p, ok := c.touches[id]; !ok || p == image.Pt(x, y) {
+		return
+	}
+	before := c.touchCenter()
+	c.touches[id] = image.Pt(x, y)
+
+	switch len(c.touches) {
+	case 1:
+		if id == c.primaryTouch {
+			c.inputMouseMove(x, y)
+		}
+	case 2:
+		after := c.touchCenter()
+		c.inputMouseMove(after.X, after.Y)
+		c.inputScroll(before.X-after.X, before.Y-after.Y)
+	}
+}
+
+// inputTouchUp handles the release of a touch at (x, y).
+func (c *Context) inputTouchUp(id ebiten.TouchID, x, y int) {
+	if _, ok := c.touches[id]; !ok {
+		return
+	}
+	delete(c.touches, id)
+	if id != c.primaryTouch {
+		return
+	}
+	// a short tap is pressed and released in the same tick
+	if c.touchPressPending {
+		c.touchPressPending = false
+		c.inputMouseDown(x, y, ebiten.MouseButtonLeft)
+	}
+	if (c.mouseDown & mouseLeft) != 0 {
+		c.inputMouseUp(x, y, ebiten.MouseButtonLeft)
+	}
+}
+
+// inputTouchHold updates the primary touch on every tick. It presses the pending left button once the touch moves past
+// the touch slop, and turns a touch held in place for touchLongPressTicks into a right click instead.
+func (c *Context) inputTouchHold() {
+	p, ok := c.touches[c.primaryTouch]
+	if !ok || len(c.touches) != 1 || c.touchHoldDone {
+		return
+	}
+	if d := p.Sub(c.touchStart); d.X*d.X+d.Y*d.Y > c.style.touchSlop*c.style.touchSlop {
+		c.touchHoldDone = true
+		if c.touchPressPending {
+			c.touchPressPending = false
+			c.inputMouseDown(p.X, p.Y, ebiten.MouseButtonLeft)
+		}
+		return
+	}
+	if c.tick-c.touchStartTick >= touchLongPressTicks {
+		c.touchHoldDone = true
+		c.touchPressPending = false
+		c.inputMouseDown(p.X, p.Y, ebiten.MouseButtonRight)
+		c.inputMouseUp(p.X, p.Y, ebiten.MouseButtonRight)
+	}
+}
+
+// inputShortcutKey handles a press of the key of a registered shortcut.
+func (c *Context) inputShortcutKey(key ebiten.Key) {
+	if !slices.Contains(c.shortcutKeys, key) {
+		c.shortcutKeys = append(c.shortcutKeys, key)
+	}
+}
+
+// KeyPressed reports whether key was pressed in the current frame, or repeated while held.
+// Modifier keys are not repeated.
+func (c *Context) KeyPressed(key ebiten.Key) bool {
+	return key >= 0 && key <= ebiten.KeyMax && c.keysPressed[key]
+}
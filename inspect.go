@@ -0,0 +1,295 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// inspectTag represents the options parsed from a `debugui` struct tag.
+type inspectTag struct {
+	min      float64
+	max      float64
+	hasRange bool
+	step     float64
+	digits   int
+	readOnly bool
+	skip     bool
+}
+
+// parseInspectTag parses a struct tag like `debugui:"min=0,max=10,step=0.1,digits=1,readonly"`.
+// The tag "-" skips the field. Unknown or malformed options are ignored.
+func parseInspectTag(tag string) inspectTag {
+	t := inspectTag{
+		digits: -1,
+	}
+	if tag == "-" {
+		t.skip = true
+		return t
+	}
+	var hasMin, hasMax bool
+	for _, opt := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch key {
+		case "readonly":
+			t.readOnly = true
+		case "digits":
+			if v, err := strconv.Atoi(value); err == nil {
+				t.digits = v
+			}
+		case "min", "max", "step":
+			v, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "min":
+				t.min, hasMin = v, true
+			case "max":
+				t.max, hasMax = v, true
+			case "step":
+				t.step = v
+			}
+		}
+	}
+	t.hasRange = hasMin && hasMax && t.min < t.max
+	return t
+}
+
+// Inspect renders controls to view and edit the value v points to, typically a struct, in a tree node labeled label.
+// Bools are edited with checkboxes, numbers with number boxes or sliders, and strings with text boxes.
+// Structs, slices, arrays, maps and pointers are shown as nested tree nodes. Values that cannot be set, like map
+// values and unexported fields, are shown read-only or skipped.
+//
+// Struct fields can be configured with a `debugui` tag, e.g. `debugui:"min=0,max=10,step=0.1,readonly"`.
+// With both min and max, a number is edited with a slider. digits sets the number of decimal places, and "-" hides
+// the field.
+func (c *Context) Inspect(label string, v any) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		c.SetLayoutRow([]int{inspectLabelWidth, -1}, 0)
+		c.Label(label)
+		c.Label(fmt.Sprint(v))
+		return
+	}
+	c.inspectValue(label, rv.Elem(), inspectTag{digits: -1}, optionExpanded)
+}
+
+// inspectValue renders the controls for v. Composite values are rendered as a tree node with the given option.
+func (c *Context) inspectValue(label string, v reflect.Value, tag inspectTag, opt option) {
+	switch v.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		c.inspectComposite(label, v, tag, opt)
+		return
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			c.inspectLabel(label, "nil")
+			return
+		}
+		// a pointer makes the pointed value settable
+		c.inspectValue(label, v.Elem(), tag, 0)
+		return
+	}
+
+	c.SetLayoutRow([]int{inspectLabelWidth, -1}, 0)
+	c.Label(label)
+	if tag.readOnly || !v.CanSet() {
+		c.inspectReadOnly(v, tag)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		c.Checkbox("", v.Addr().Interface().(*bool))
+	case reflect.String:
+		c.TextBox(v.Addr().Interface().(*string))
+	case reflect.Float64:
+		c.inspectNumber(v.Addr().Interface().(*float64), tag, 2, 0)
+	case reflect.Float32, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		c.inspectProxyNumber(v, tag)
+	default:
+		c.inspectReadOnly(v, tag)
+	}
+}
+
+// inspectComposite renders a struct, a slice, an array or a map as a tree node.
+func (c *Context) inspectComposite(label string, v reflect.Value, tag inspectTag, opt option) {
+	title := label
+	if v.Kind() != reflect.Struct {
+		title = fmt.Sprintf("%s [%d]", label, v.Len())
+	}
+	c.treeNode(title, label, opt, func(res Response) {
+		c.pushID([]byte(label))
+		defer c.popID()
+
+		switch v.Kind() {
+		case reflect.Struct:
+			t := v.Type()
+			for i := 0; i < t.NumField(); i++ {
+				f := t.Field(i)
+				if !f.IsExported() {
+					continue
+				}
+				ftag := parseInspectTag(f.Tag.Get("debugui"))
+				if ftag.skip {
+					continue
+				}
+				ftag.readOnly = ftag.readOnly || tag.readOnly
+				c.inspectValue(f.Name, v.Field(i), ftag, 0)
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				c.inspectValue(fmt.Sprintf("[%d]", i), v.Index(i), tag, 0)
+			}
+		case reflect.Map:
+			// sort the keys for a stable order
+			keys := v.MapKeys()
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = fmt.Sprint(k.Interface())
+			}
+			idx := make([]int, len(keys))
+			for i := range idx {
+				idx[i] = i
+			}
+			sort.Slice(idx, func(i, j int) bool {
+				return names[idx[i]] < names[idx[j]]
+			})
+			for _, i := range idx {
+				c.inspectValue(names[i], v.MapIndex(keys[i]), tag, 0)
+			}
+		}
+	})
+}
+
+// inspectLabel renders a row with a label and a text.
+func (c *Context) inspectLabel(label string, text string) {
+	c.SetLayoutRow([]int{inspectLabelWidth, -1}, 0)
+	c.Label(label)
+	c.Label(text)
+}
+
+// inspectReadOnly renders a scalar value that cannot be edited.
+func (c *Context) inspectReadOnly(v reflect.Value, tag inspectTag) {
+	switch {
+	case !v.CanInterface():
+		c.Label(v.Type().String())
+	case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+		digits := tag.digits
+		if digits < 0 {
+			digits = 2
+		}
+		c.Label(formatNumber(v.Float(), digits))
+	default:
+		c.Label(fmt.Sprint(v.Interface()))
+	}
+}
+
+// inspectNumber renders a slider if the tag has a range, or a number box otherwise.
+func (c *Context) inspectNumber(value *float64, tag inspectTag, digits int, opt option) Response {
+	step := tag.step
+	if tag.digits >= 0 {
+		digits = tag.digits
+	}
+	if tag.hasRange {
+		return c.slider(value, tag.min, tag.max, step, digits, optionAlignCenter|opt)
+	}
+	if step == 0 {
+		step = 1
+	}
+	return c.number(value, step, digits, optionAlignCenter|opt)
+}
+
+// inspectProxyNumber renders a number control for an integer or float32 value through a retained float64 proxy.
+// While the control is dragged, the proxy keeps the fractional value so that a step below 1 accumulates.
+// The changes are recorded in the undo history against the value itself, as the proxy may be pruned.
+func (c *Context) inspectProxyNumber(v reflect.Value, tag inspectTag) {
+	key := inspectProxyKey{addr: v.Addr().Pointer(), kind: v.Kind()}
+	p, ok := c.inspectProxies[key]
+	if !ok {
+		if c.inspectProxies == nil {
+			c.inspectProxies = make(map[inspectProxyKey]*inspectProxy)
+		}
+		p = &inspectProxy{value: inspectFloat(v)}
+		p.synced = p.value
+		c.inspectProxies[key] = p
+	}
+	p.lastUpdate = c.tick
+	id := c.idFromBytes(ptrToBytes(unsafe.Pointer(&p.value)))
+
+	// pick up changes made by the game or by undo and redo, and round the proxy once a drag ends
+	if f := inspectFloat(v); f != p.synced {
+		p.value = f
+	} else if p.value != p.synced && c.focus != id {
+		p.value = f
+	}
+
+	before := inspectFloat(v)
+	digits := 2
+	if v.Kind() != reflect.Float32 {
+		digits = 0
+	}
+	if c.inspectNumber(&p.value, tag, digits, optionNoUndo) != 0 {
+		inspectSetFloat(v, p.value)
+		if c.focus != id {
+			p.value = inspectFloat(v)
+		}
+	}
+	p.synced = inspectFloat(v)
+	recordUndoFunc(c, id, before, p.synced, func(f float64) {
+		inspectSetFloat(v, f)
+	}, undoLabel[float64])
+}
+
+// pruneInspectProxies removes the proxies of the values not inspected in the current frame,
+// so that a proxy is not reused for another value at the same address.
+func (c *Context) pruneInspectProxies() {
+	for key, p := range c.inspectProxies {
+		if p.lastUpdate != c.tick {
+			delete(c.inspectProxies, key)
+		}
+	}
+}
+
+// inspectFloat returns the numeric value v as a float64.
+func inspectFloat(v reflect.Value) float64 {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	default:
+		return float64(v.Uint())
+	}
+}
+
+// inspectSetFloat sets the numeric value v to f, rounding it for integers. Values out of the range of v are ignored.
+func inspectSetFloat(v reflect.Value, f float64) {
+	switch v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if !v.OverflowFloat(f) {
+			v.SetFloat(f)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := int64(math.Round(f))
+		if !v.OverflowInt(n) {
+			v.SetInt(n)
+		}
+	default:
+		if f < 0 {
+			return
+		}
+		n := uint64(math.Round(f))
+		if !v.OverflowUint(n) {
+			v.SetUint(n)
+		}
+	}
+}
@@ -46,6 +46,16 @@ func (c *Context) SetLayoutRow(widths []int, height int) {
 	layout.itemIndex = 0
 }
 
+// layoutNextWithHeight is like layoutNext, but uses height instead of the row height for the next cell only.
+func (c *Context) layoutNextWithHeight(height int) image.Rectangle {
+	layout := c.layout()
+	h := layout.height
+	layout.height = height
+	r := c.layoutNext()
+	layout.height = h
+	return r
+}
+
 func (c *Context) layoutNext() image.Rectangle {
 	layout := c.layout()
 
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"sort"
+	"strings"
+	"unicode"
+	"unsafe"
+)
+
+const commandPaletteName = "!palette"
+
+// RegisterCommand registers an action shown in the command palette with the given name and category.
+// Registering a command with the same name and category again replaces its action.
+// The palette is opened by ctrl+P or OpenCommandPalette, and the action is called in Update.
+func (d *DebugUI) RegisterCommand(name, category string, action func()) {
+	d.ctx.registerCommand(name, category, action)
+}
+
+func (c *Context) registerCommand(name, category string, action func()) {
+	for i := range c.paletteCommands {
+		if cmd := &c.paletteCommands[i]; cmd.name == name && cmd.category == category {
+			cmd.action = action
+			return
+		}
+	}
+	c.paletteCommands = append(c.paletteCommands, paletteCommand{
+		name:     name,
+		category: category,
+		action:   action,
+	})
+}
+
+// OpenCommandPalette opens the command palette.
+func (c *Context) OpenCommandPalette() {
+	if c.paletteOpen {
+		return
+	}
+	c.paletteOpen = true
+	c.paletteOpened = true
+	c.paletteQuery = ""
+	c.paletteSelected = 0
+}
+
+// closeCommandPalette closes the command palette.
+func (c *Context) closeCommandPalette() {
+	c.paletteOpen = false
+	c.paletteQuery = ""
+}
+
+// handlePaletteKeys toggles the command palette by ctrl+P.
+func (c *Context) handlePaletteKeys() {
+	if (c.keyDown&keyModifiers) != keyControl || (c.keyPressed&keyP) == 0 {
+		return
+	}
+	if c.paletteOpen {
+		c.closeCommandPalette()
+		return
+	}
+	c.OpenCommandPalette()
+}
+
+// fuzzyScore returns how well pattern matches str as a case-insensitive subsequence, or -1 if it doesn't match.
+// Consecutive matches and matches at the start of words score higher. Spaces in pattern are ignored.
+func fuzzyScore(pattern, str string) int {
+	p := []rune(strings.ToLower(strings.ReplaceAll(pattern, " ", "")))
+	s := []rune(strings.ToLower(str))
+	var score, pi int
+	prev := -2
+	for i, r := range s {
+		if pi == len(p) {
+			break
+		}
+		if r != p[pi] {
+			continue
+		}
+		score++
+		if i == prev+1 {
+			score += 5
+		}
+		if i == 0 || (!unicode.IsLetter(s[i-1]) && !unicode.IsDigit(s[i-1])) {
+			score += 3
+		}
+		prev = i
+		pi++
+	}
+	if pi < len(p) {
+		return -1
+	}
+	return score
+}
+
+// matchCommands returns the registered commands matching query, from the best match.
+func (c *Context) matchCommands(query string) []*paletteCommand {
+	type match struct {
+		cmd   *paletteCommand
+		score int
+	}
+	var matches []match
+	for i := range c.paletteCommands {
+		cmd := &c.paletteCommands[i]
+		if score := fuzzyScore(query, cmd.category+" "+cmd.name); score >= 0 {
+			matches = append(matches, match{cmd: cmd, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	cmds := make([]*paletteCommand, len(matches))
+	for i, m := range matches {
+		cmds[i] = m.cmd
+	}
+	return cmds
+}
+
+// commandPalette renders the command palette if it is open.
+// The palette is modal: no other window can be hovered while it is open, and clicking outside closes it.
+// Up and Down select a command, Enter runs the selected command, and Escape closes the palette.
+func (c *Context) commandPalette() {
+	if !c.paletteOpen {
+		return
+	}
+
+	var run func()
+	opt := optionNoTitle | optionNoResize | optionNoClose
+	c.window("", commandPaletteName, image.Rect(100, 60, 500, 360), opt, nil, func(res Response, layout Layout) {
+		cnt := c.currentContainer()
+		if c.paletteOpened {
+			c.bringToFront(cnt)
+		}
+		// keep the other windows from being hovered
+		c.nextHoverRoot = cnt
+
+		// the query is not recorded in the undo history, and cannot be submitted without a matching command
+		c.SetLayoutRow([]int{-1}, 0)
+		id := c.pushID(ptrToBytes(unsafe.Pointer(&c.paletteQuery)))
+		tres := c.textBoxRaw(&c.paletteQuery, id, 0, nil, func(text string) bool {
+			return len(c.matchCommands(text)) > 0
+		})
+		c.popID()
+		if c.paletteOpened {
+			c.setFocus(id)
+			c.paletteOpened = false
+		}
+
+		cmds := c.matchCommands(c.paletteQuery)
+		if (tres & ResponseChange) != 0 {
+			c.paletteSelected = 0
+		}
+		if (c.keyPressed & keyUp) != 0 {
+			c.paletteSelected--
+		}
+		if (c.keyPressed & keyDown) != 0 {
+			c.paletteSelected++
+		}
+		c.paletteSelected = clamp(c.paletteSelected, 0, max(len(cmds)-1, 0))
+
+		if (tres&ResponseSubmit) != 0 && len(cmds) > 0 {
+			run = cmds[c.paletteSelected].action
+		}
+
+		for i, cmd := range cmds {
+			label := cmd.name
+			if len(cmd.category) > 0 {
+				label = cmd.category + ": " + cmd.name
+			}
+			if c.button(label, fmt.Sprintf("!command%d", i), 0) != 0 {
+				run = cmd.action
+			}
+			if i == c.paletteSelected {
+				c.drawFrame(c.lastRect, ColorButtonFocus)
+				c.drawControlText(label, c.lastRect, ColorText, 0)
+			}
+		}
+
+		if c.mousePressed != 0 && !c.mousePos.In(cnt.layout.Rect) {
+			c.closeCommandPalette()
+		}
+	})
+
+	if (c.keyPressed & keyEscape) != 0 {
+		c.closeCommandPalette()
+	}
+	if run != nil {
+		c.closeCommandPalette()
+		run()
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"runtime"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// perfMetric is a value shown in the performance window with its history.
+type perfMetric struct {
+	label   string
+	format  string
+	history *RingBuffer
+}
+
+// perfMetrics retrieves or initializes the metrics of the performance window.
+func (c *Context) perfMetrics() []perfMetric {
+	if c.perf == nil {
+		for _, m := range [...]struct{ label, format string }{
+			{"FPS", "%.1f"},
+			{"TPS", "%.1f"},
+			{"Game update", "%.2f ms"},
+			{"Game draw", "%.2f ms"},
+			{"UI update", "%.2f ms"},
+			{"UI draw", "%.2f ms"},
+			{"Heap", "%.1f MiB"},
+			{"GC count", "%.0f"},
+			{"GC pause", "%.3f ms"},
+			{"Goroutines", "%.0f"},
+			{"Commands", "%.0f"},
+		} {
+			c.perf = append(c.perf, perfMetric{
+				label:   m.label,
+				format:  m.format,
+				history: NewRingBuffer(perfHistorySize),
+			})
+		}
+	}
+	return c.perf
+}
+
+// SetGameUpdateTime sets the time the game's own update took in the current frame, shown in PerformanceWindow.
+func (d *DebugUI) SetGameUpdateTime(t time.Duration) {
+	d.ctx.gameUpdateDuration = t
+}
+
+// SetGameDrawTime sets the time the game's own drawing took in the last frame, shown in PerformanceWindow.
+func (d *DebugUI) SetGameDrawTime(t time.Duration) {
+	d.ctx.gameDrawDuration = t
+}
+
+// PerformanceWindow renders a window showing the frame rates, the update and draw times the game reports by
+// SetGameUpdateTime and SetGameDrawTime, the memory and GC statistics, the number of goroutines, and the time and the
+// number of draw commands debugui itself takes, each with a sparkline of its recent history.
+// The statistics are sampled only while the window is open. The memory statistics are read every perfMemStatsInterval,
+// as reading them stops the world.
+func (c *Context) PerformanceWindow() {
+	c.Window("Performance", image.Rect(40, 40, 400, 380), func(res Response, layout Layout) {
+		if time.Since(c.perfMemStatsTime) >= perfMemStatsInterval {
+			runtime.ReadMemStats(&c.perfMemStats)
+			c.perfMemStatsTime = time.Now()
+		}
+		m := &c.perfMemStats
+		var pause uint64
+		if m.NumGC > 0 {
+			pause = m.PauseNs[(m.NumGC+255)%256]
+		}
+		values := [...]float64{
+			ebiten.ActualFPS(),
+			ebiten.ActualTPS(),
+			float64(c.gameUpdateDuration.Microseconds()) / 1000,
+			float64(c.gameDrawDuration.Microseconds()) / 1000,
+			float64(c.updateDuration.Microseconds()) / 1000,
+			float64(c.drawDuration.Microseconds()) / 1000,
+			float64(m.HeapAlloc) / (1 << 20),
+			float64(m.NumGC),
+			float64(pause) / 1e6,
+			float64(runtime.NumGoroutine()),
+			float64(c.lastCommandCount),
+		}
+
+		c.SetLayoutRow([]int{80, 80, -1}, 0)
+		for i, metric := range c.perfMetrics() {
+			metric.history.Push(values[i])
+			c.Label(metric.label)
+			c.Label(fmt.Sprintf(metric.format, values[i]))
+			c.plot("", metric.history.Values(), nil, false, true)
+		}
+	})
+}
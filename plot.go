@@ -0,0 +1,253 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// PlotSeries is an additional series of values drawn in a plot.
+type PlotSeries struct {
+	// Label is the name shown in the legend.
+	Label string
+
+	// Values are the samples, from the oldest to the newest.
+	Values []float64
+
+	// Color is the color of the series. If Color is nil, a color is picked from the default palette.
+	Color color.Color
+}
+
+// PlotOptions represents options for PlotLines and PlotHistogram.
+type PlotOptions struct {
+	// Min and Max are the range of the Y axis. If Min equals Max, the range is computed from the values.
+	Min float64
+	Max float64
+
+	// Series are drawn in addition to the main values.
+	Series []PlotSeries
+}
+
+// RingBuffer is a fixed-capacity buffer of float64 values for streaming data into plots.
+// When the buffer is full, pushing a value drops the oldest one.
+type RingBuffer struct {
+	values []float64
+	start  int
+	len    int
+}
+
+// NewRingBuffer returns a new RingBuffer holding at most capacity values.
+func NewRingBuffer(capacity int) *RingBuffer {
+	return &RingBuffer{
+		values: make([]float64, capacity),
+	}
+}
+
+// Push appends v, dropping the oldest value if the buffer is full.
+func (r *RingBuffer) Push(v float64) {
+	if len(r.values) == 0 {
+		return
+	}
+	if r.len < len(r.values) {
+		r.values[(r.start+r.len)%len(r.values)] = v
+		r.len++
+		return
+	}
+	r.values[r.start] = v
+	r.start = (r.start + 1) % len(r.values)
+}
+
+// Len returns the number of values in the buffer.
+func (r *RingBuffer) Len() int {
+	return r.len
+}
+
+// Last returns the newest value, or 0 if the buffer is empty.
+func (r *RingBuffer) Last() float64 {
+	if r.len == 0 {
+		return 0
+	}
+	return r.values[(r.start+r.len-1)%len(r.values)]
+}
+
+// AppendValues appends the values in the buffer to dst from the oldest to the newest, and returns the extended slice.
+func (r *RingBuffer) AppendValues(dst []float64) []float64 {
+	for i := 0; i < r.len; i++ {
+		dst = append(dst, r.values[(r.start+i)%len(r.values)])
+	}
+	return dst
+}
+
+// Values returns the values in the buffer from the oldest to the newest.
+func (r *RingBuffer) Values() []float64 {
+	return r.AppendValues(make([]float64, 0, r.len))
+}
+
+var plotPalette = [...]color.RGBA{
+	{90, 170, 250, 255},
+	{250, 150, 60, 255},
+	{120, 210, 120, 255},
+	{230, 90, 90, 255},
+	{190, 130, 230, 255},
+}
+
+// plotRange returns the range of the Y axis for the series.
+func plotRange(series []PlotSeries, options *PlotOptions) (float64, float64) {
+	if options != nil && options.Min != options.Max {
+		return options.Min, options.Max
+	}
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, s := range series {
+		for _, v := range s.Values {
+			lo = min(lo, v)
+			hi = max(hi, v)
+		}
+	}
+	if math.IsInf(lo, 0) {
+		return 0, 1
+	}
+	if lo == hi {
+		return lo - 1, hi + 1
+	}
+	return lo, hi
+}
+
+// plot renders the values and the additional series of the options as lines or as a histogram, with a legend.
+// While the plot is hovered, the nearest sample is marked and its values are shown in the legend, or in the top-right
+// corner of the plot if no series has a label and the legend is omitted.
+// If sparkline is true, the plot has neither a legend nor the hover readout.
+func (c *Context) plot(label string, values []float64, options *PlotOptions, histogram, sparkline bool) {
+	series := []PlotSeries{{Label: label, Values: values}}
+	if options != nil {
+		series = append(series, options.Series...)
+	}
+	var n int
+	var legend bool
+	for i := range series {
+		n = max(n, len(series[i].Values))
+		legend = legend || (len(series[i].Label) > 0 && !sparkline)
+		if series[i].Color == nil {
+			series[i].Color = plotPalette[i%len(plotPalette)]
+		}
+	}
+	lo, hi := plotRange(series, options)
+
+	c.control(0, 0, func(r image.Rectangle) Response {
+		c.drawFrame(r, ColorBase)
+		lh := lineHeight()
+		area := r.Inset(1)
+		if legend {
+			area.Min.Y += lh
+		}
+		if n == 0 || area.Dx() <= 0 || area.Dy() <= 0 {
+			c.drawControlText(label, image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+lh), ColorText, 0)
+			return 0
+		}
+
+		// map samples to positions
+		sampleX := func(i int) float32 {
+			if histogram {
+				return float32(area.Min.X) + float32(i)*float32(area.Dx())/float32(n)
+			}
+			if n == 1 {
+				return float32(area.Min.X)
+			}
+			return float32(area.Min.X) + float32(i)*float32(area.Dx()-1)/float32(n-1)
+		}
+		sampleY := func(v float64) float32 {
+			t := clampF((v-lo)/(hi-lo), 0, 1)
+			return float32(area.Max.Y) - float32(t)*float32(area.Dy())
+		}
+
+		// find the hovered sample
+		hovered := -1
+		if !sparkline && c.mouseOver(area) {
+			x := float64(c.mousePos.X - area.Min.X)
+			if histogram {
+				hovered = clamp(int(x*float64(n)/float64(area.Dx())), 0, n-1)
+			} else if n > 1 {
+				hovered = clamp(int(math.Round(x*float64(n-1)/float64(area.Dx()-1))), 0, n-1)
+			} else {
+				hovered = 0
+			}
+			x0 := int(sampleX(hovered))
+			if histogram {
+				x0 = int((sampleX(hovered) + sampleX(hovered+1)) / 2)
+			}
+			c.drawRect(image.Rect(x0, area.Min.Y, x0+1, area.Max.Y), c.style.colors[ColorButtonHover])
+		}
+
+		// draw the series
+		c.pushClipRect(area)
+		if histogram {
+			for j, s := range series {
+				for i, v := range s.Values {
+					x0, x1 := sampleX(i), sampleX(i+1)
+					w := (x1 - x0) / float32(len(series))
+					bx := int(x0 + w*float32(j))
+					c.drawRect(image.Rect(bx, int(sampleY(v)), max(bx+1, int(x0+w*float32(j+1))-1), area.Max.Y), s.Color)
+				}
+			}
+		} else {
+			lines := make([][]float32, len(series))
+			for j, s := range series {
+				for i, v := range s.Values {
+					lines[j] = append(lines[j], sampleX(i), sampleY(v))
+				}
+			}
+			c.DrawControl(func(screen *ebiten.Image) {
+				for j, pts := range lines {
+					for i := 2; i+1 < len(pts); i += 2 {
+						vector.StrokeLine(screen, pts[i-2], pts[i-1], pts[i], pts[i+1], 1, series[j].Color, true)
+					}
+				}
+			})
+		}
+		c.popClipRect()
+
+		// draw the hovered values in the corner without a legend
+		if !legend {
+			if hovered < 0 {
+				return 0
+			}
+			var text string
+			for _, s := range series {
+				if hovered >= len(s.Values) {
+					continue
+				}
+				if len(text) > 0 {
+					text += ", "
+				}
+				text += fmt.Sprintf(realFmt, s.Values[hovered])
+			}
+			c.pushClipRect(area)
+			c.drawText(text, image.Pt(area.Max.X-c.style.padding-textWidth(text), area.Min.Y), c.style.colors[ColorText])
+			c.popClipRect()
+			return 0
+		}
+
+		// draw the legend
+		c.pushClipRect(r)
+		x := r.Min.X + c.style.padding
+		for _, s := range series {
+			text := s.Label
+			if hovered >= 0 && hovered < len(s.Values) {
+				text = fmt.Sprintf("%s: "+realFmt, s.Label, s.Values[hovered])
+			}
+			box := image.Rect(x, r.Min.Y+lh/4, x+lh/2, r.Min.Y+lh/4+lh/2)
+			c.drawRect(box, s.Color)
+			x += box.Dx() + c.style.padding/2
+			c.drawText(text, image.Pt(x, r.Min.Y), c.style.colors[ColorText])
+			x += textWidth(text) + c.style.padding*2
+		}
+		c.popClipRect()
+		return 0
+	})
+}
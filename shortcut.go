@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"slices"
+	"strings"
+)
+
+// reservedShortcuts are the chords handled by debugui itself.
+var reservedShortcuts = []string{"ctrl+Z", "ctrl+Y", "ctrl+shift+Z", "ctrl+P", "Tab", "shift+Tab"}
+
+// parseShortcut parses a chord like "ctrl+shift+D" into a shortcut without an action.
+// The modifiers are ctrl, shift and alt, and the key is an Ebitengine key name. Names are case-insensitive.
+func parseShortcut(chord string) (shortcut, error) {
+	var s shortcut
+	parts := strings.Split(chord, "+")
+	for _, p := range parts[:len(parts)-1] {
+		switch strings.ToLower(strings.TrimSpace(p)) {
+		case "ctrl", "control":
+			s.mods |= keyControl
+		case "shift":
+			s.mods |= keyShift
+		case "alt":
+			s.mods |= keyAlt
+		default:
+			return shortcut{}, fmt.Errorf("debugui: unknown modifier %q in shortcut %q", p, chord)
+		}
+	}
+	if err := s.key.UnmarshalText([]byte(strings.TrimSpace(parts[len(parts)-1]))); err != nil {
+		return shortcut{}, fmt.Errorf("debugui: unknown key in shortcut %q", chord)
+	}
+	if (keyToInt(s.key) & keyModifiers) != 0 {
+		return shortcut{}, fmt.Errorf("debugui: shortcut %q has no key other than modifiers", chord)
+	}
+	s.chord = s.String()
+	return s, nil
+}
+
+// String returns the canonical form of the chord, like "ctrl+shift+D".
+func (s shortcut) String() string {
+	var b strings.Builder
+	if (s.mods & keyControl) != 0 {
+		b.WriteString("ctrl+")
+	}
+	if (s.mods & keyShift) != 0 {
+		b.WriteString("shift+")
+	}
+	if (s.mods & keyAlt) != 0 {
+		b.WriteString("alt+")
+	}
+	b.WriteString(s.key.String())
+	return b.String()
+}
+
+// RegisterShortcut registers action to be called in Update when the chord is pressed, like "ctrl+shift+D".
+// A chord consists of the modifiers ctrl, shift and alt, and an Ebitengine key name, joined by '+'.
+// description tells what the shortcut does, and is shown in ShortcutWindow.
+// Shortcuts are not triggered while a text is being edited.
+// RegisterShortcut returns an error if the chord cannot be parsed, or conflicts with a registered shortcut or one used
+// by debugui itself.
+func (d *DebugUI) RegisterShortcut(chord, description string, action func()) error {
+	return d.ctx.registerShortcut(chord, description, action)
+}
+
+func (c *Context) registerShortcut(chord, description string, action func()) error {
+	s, err := parseShortcut(chord)
+	if err != nil {
+		return err
+	}
+	for _, r := range reservedShortcuts {
+		if rs, _ := parseShortcut(r); rs.mods == s.mods && rs.key == s.key {
+			return fmt.Errorf("debugui: shortcut %q is used by debugui", s.chord)
+		}
+	}
+	for _, r := range c.shortcuts {
+		if r.mods == s.mods && r.key == s.key {
+			return fmt.Errorf("debugui: shortcut %q is already registered", s.chord)
+		}
+	}
+	s.action = action
+	s.description = description
+	c.shortcuts = append(c.shortcuts, s)
+	return nil
+}
+
+// handleShortcuts calls the actions of the shortcuts whose chords were pressed.
+// The exact set of modifiers has to be held. Shortcuts are suppressed while a text was being edited in the last frame.
+func (c *Context) handleShortcuts() {
+	if len(c.shortcutKeys) == 0 {
+		return
+	}
+	if c.textEditing {
+		return
+	}
+	mods := c.keyDown & keyModifiers
+	for _, s := range c.shortcuts {
+		if s.mods == mods && slices.Contains(c.shortcutKeys, s.key) {
+			s.action()
+		}
+	}
+}
+
+// ShortcutWindow renders a window listing the registered shortcuts and their descriptions.
+func (c *Context) ShortcutWindow(rect image.Rectangle) {
+	c.Window("Shortcuts", rect, func(res Response, layout Layout) {
+		c.SetLayoutRow([]int{100, -1}, 0)
+		for _, s := range c.shortcuts {
+			c.Label(s.chord)
+			c.Label(s.description)
+		}
+	})
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// TableColumn represents a column of a table.
+type TableColumn struct {
+	// Label is the text of the column header.
+	Label string
+
+	// Width is the initial width of the column. If Width is 0, the default control width is used.
+	Width int
+}
+
+// tableState retrieves or initializes the retained column widths and sort state of a table.
+func (c *Context) tableState(id controlID, columns []TableColumn) *tableState {
+	st, ok := c.tableStates[id]
+	if !ok {
+		if c.tableStates == nil {
+			c.tableStates = make(map[controlID]*tableState)
+		}
+		st = &tableState{
+			sortColumn: -1,
+		}
+		c.tableStates[id] = st
+	}
+	if len(st.widths) != len(columns) {
+		st.widths = st.widths[:0]
+		for _, col := range columns {
+			w := col.Width
+			if w == 0 {
+				w = c.style.size.X + c.style.padding*2
+			}
+			st.widths = append(st.widths, w)
+		}
+	}
+	return st
+}
+
+// table renders a table with a header row and rowCount rows in the next layout cell.
+// f is called for each visible cell and must render exactly one control, e.g. a Label, for the cell.
+// Clicking a header sorts by the column or reverses the direction, and dragging the border right of a header resizes
+// the column. The header stays in place while the rows scroll, and only the visible rows are rendered.
+// Returns the sort column, which is -1 if the table is not sorted, and whether the order is ascending.
+func (c *Context) table(idStr string, columns []TableColumn, rowCount int, f func(row, col int)) (int, bool) {
+	id := c.pushID([]byte(idStr))
+	defer c.popID()
+	st := c.tableState(id, columns)
+
+	r := c.layoutNext()
+	rowH := c.style.size.Y + c.style.padding*2
+	stride := rowH + c.style.spacing
+	header := image.Rect(r.Min.X, r.Min.Y, r.Max.X, min(r.Min.Y+rowH, r.Max.Y))
+	body := image.Rect(r.Min.X, header.Max.Y, r.Max.X, r.Max.Y)
+
+	cnt := c.container(id, 0)
+	cnt.layout.Rect = body
+	c.drawFrame(body, ColorPanelBG)
+
+	// header row, scrolled horizontally with the body
+	c.pushClipRect(header)
+	x := header.Min.X + c.style.padding - cnt.layout.Scroll.X
+	for i, col := range columns {
+		cell := image.Rect(x, header.Min.Y, x+st.widths[i], header.Max.Y)
+
+		hid := c.idFromBytes([]byte(fmt.Sprintf("!header%d", i)))
+		c.controlAt(hid, cell, 0, func(r image.Rectangle) Response {
+			c.setCursor(hid, ebiten.CursorShapePointer)
+			if (c.mousePressed == mouseLeft && c.focus == hid) || c.navActivated(hid) {
+				if st.sortColumn == i {
+					st.ascending = !st.ascending
+				} else {
+					st.sortColumn = i
+					st.ascending = true
+				}
+			}
+			label := col.Label
+			if st.sortColumn == i {
+				if st.ascending {
+					label += " ^"
+				} else {
+					label += " v"
+				}
+			}
+			c.drawControlFrame(hid, r, ColorButton, 0)
+			c.drawControlText(label, r, ColorText, 0)
+			return 0
+		})
+
+		// resize handle in the gap right of the header, also resized by the arrow keys while focused
+		rid := c.idFromBytes([]byte(fmt.Sprintf("!resize%d", i)))
+		c.controlAt(rid, image.Rect(cell.Max.X, cell.Min.Y, cell.Max.X+c.style.spacing, cell.Max.Y), 0, func(r image.Rectangle) Response {
+			c.setCursor(rid, ebiten.CursorShapeEWResize)
+			if c.focus == rid && c.mouseDown == mouseLeft {
+				st.widths[i] = max(tableMinColumnWidth, st.widths[i]+c.mouseDelta.X)
+			}
+			if step := c.keyStep(rid); step != 0 {
+				st.widths[i] = max(tableMinColumnWidth, st.widths[i]+int(step)*tableResizeKeyStep)
+			}
+			return 0
+		})
+
+		x = cell.Max.X + c.style.spacing
+	}
+	c.popClipRect()
+
+	// body rows
+	c.containerStack = append(c.containerStack, cnt)
+	c.pushContainerBody(cnt, body, 0)
+	c.pushClipRect(cnt.layout.Body)
+
+	totalW := c.style.spacing * (len(columns) - 1)
+	for _, w := range st.widths {
+		totalW += w
+	}
+	first := clamp((cnt.layout.Scroll.Y-c.style.padding)/stride, 0, rowCount)
+	last := clamp((cnt.layout.Scroll.Y+cnt.layout.Body.Dy())/stride+1, 0, rowCount)
+	c.layout().nextRow = first * stride
+	c.SetLayoutRow(st.widths, rowH)
+	for row := first; row < last; row++ {
+		// stripe odd rows
+		if row%2 == 1 {
+			b := c.layout().body
+			y := b.Min.Y + row*stride
+			c.drawRect(image.Rect(b.Min.X, y, b.Min.X+totalW, y+rowH), c.style.colors[ColorBase])
+		}
+		for col := range columns {
+			f(row, col)
+		}
+	}
+
+	// extend the content size to all the rows
+	l := c.layout()
+	l.max.X = max(l.max.X, l.body.Min.X+totalW)
+	l.max.Y = max(l.max.Y, l.body.Min.Y+rowCount*stride-c.style.spacing)
+
+	c.popClipRect()
+	c.popContainer()
+
+	return st.sortColumn, st.ascending
+}
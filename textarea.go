@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// textArea renders a multiline text editor in the next layout cell with the given height, tied to the string buffer buf.
+// The text is wrapped at the width of the editor and scrolled vertically with the container scrollbars.
+// While focused, the caret is moved with the arrow keys, Home and End, and Return inserts a newline.
+// Returns a Response indicating whether the text has changed.
+func (c *Context) textArea(buf *string, height int, opt option) Response {
+	id := c.pushID(ptrToBytes(unsafe.Pointer(buf)))
+	defer c.popID()
+	defer recordUndo(c, id, buf, *buf)
+
+	cnt := c.container(id, opt)
+	cnt.layout.Rect = c.layoutNextWithHeight(height)
+	c.drawControlFrame(id, cnt.layout.Rect, ColorBase, opt)
+
+	c.containerStack = append(c.containerStack, cnt)
+	c.pushContainerBody(cnt, cnt.layout.Rect, opt)
+	defer c.popContainer()
+
+	c.pushClipRect(cnt.layout.Body)
+	defer c.popClipRect()
+
+	lh := lineHeight()
+	lines := wrapLines(*buf, c.layout().body.Dx())
+	c.SetLayoutRow([]int{-1}, max(len(lines)*lh, c.layout().body.Dy()))
+
+	return c.control(id, opt|optionHoldFocus, func(r image.Rectangle) Response {
+		c.setCursor(id, ebiten.CursorShapeText)
+		var res Response
+
+		f := c.textField(id)
+		if c.focus == id {
+			// place the caret at the clicked position
+			if c.mousePressed == mouseLeft && c.mouseOver(r) {
+				pos := c.mousePos.Sub(r.Min)
+				ln := lines[clamp(pos.Y/lh, 0, len(lines)-1)]
+				p := offsetAtX(*buf, ln, pos.X)
+				f.SetTextAndSelection(*buf, p, p)
+			}
+
+			// handle text input
+			c.textEditing = true
+			f.Focus()
+			p, _ := f.Selection()
+			ln := lineAt(lines, p)
+			x := r.Min.X + textWidth((*buf)[lines[ln].start:min(p, lines[ln].end)])
+			y := r.Min.Y + (ln+1)*lh
+			handled, err := f.HandleInput(x, y)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return 0
+			}
+			if *buf != f.TextForRendering() {
+				*buf = f.TextForRendering()
+				lines = wrapLines(*buf, r.Dx())
+				res |= ResponseChange
+			}
+
+			if !handled {
+				text := *buf
+				p, _ := f.Selection()
+				last := p
+
+				// handle editing
+				if (c.keyPressed&keyBackspace) != 0 && p > 0 {
+					_, size := utf8.DecodeLastRuneInString(text[:p])
+					text = text[:p-size] + text[p:]
+					p -= size
+				}
+				if (c.keyPressed & keyReturn) != 0 {
+					text = text[:p] + "\n" + text[p:]
+					p++
+				}
+				if text != *buf {
+					*buf = text
+					lines = wrapLines(*buf, r.Dx())
+					res |= ResponseChange
+				}
+
+				// handle caret movement
+				if (c.keyPressed&keyLeft) != 0 && p > 0 {
+					_, size := utf8.DecodeLastRuneInString(text[:p])
+					p -= size
+				}
+				if (c.keyPressed&keyRight) != 0 && p < len(text) {
+					_, size := utf8.DecodeRuneInString(text[p:])
+					p += size
+				}
+				ln := lineAt(lines, p)
+				if (c.keyPressed & keyHome) != 0 {
+					p = lines[ln].start
+				}
+				if (c.keyPressed & keyEnd) != 0 {
+					p = lines[ln].end
+				}
+				if (c.keyPressed & (keyUp | keyDown)) != 0 {
+					x := textWidth(text[lines[ln].start:min(p, lines[ln].end)])
+					if (c.keyPressed&keyUp) != 0 && ln > 0 {
+						ln--
+					}
+					if (c.keyPressed&keyDown) != 0 && ln < len(lines)-1 {
+						ln++
+					}
+					p = offsetAtX(text, lines[ln], x)
+				}
+
+				if p != last || (res&ResponseChange) != 0 {
+					f.SetTextAndSelection(text, p, p)
+
+					// scroll the caret into view
+					cy := lineAt(lines, p) * lh
+					h := c.layout().body.Dy()
+					if cy < cnt.layout.Scroll.Y {
+						cnt.layout.Scroll.Y = cy
+					} else if cy+lh > cnt.layout.Scroll.Y+h {
+						cnt.layout.Scroll.Y = cy + lh - h
+					}
+				}
+			}
+		} else if *buf != f.TextForRendering() {
+			f.SetTextAndSelection(*buf, len(*buf), len(*buf))
+		}
+
+		// draw
+		color := c.style.colors[ColorText]
+		for i, ln := range lines {
+			c.drawText((*buf)[ln.start:ln.end], image.Pt(r.Min.X, r.Min.Y+i*lh), color)
+		}
+		if c.focus == id {
+			p, _ := f.Selection()
+			i := lineAt(lines, p)
+			x := r.Min.X + textWidth((*buf)[lines[i].start:min(p, lines[i].end)])
+			y := r.Min.Y + i*lh
+			c.drawRect(image.Rect(x, y, x+1, y+lh), color)
+		}
+		return res
+	})
+}
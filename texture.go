@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"math"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/colorm"
+)
+
+// textureChannelLabels holds the labels of the channel buttons. The first one shows all the channels.
+var textureChannelLabels = [...]string{"RGBA", "R", "G", "B", "A"}
+
+// textureInspectorState retrieves or initializes the retained view state of a texture inspector.
+func (c *Context) textureInspectorState(id controlID) *textureInspectorState {
+	if _, ok := c.textureInspectors[id]; !ok {
+		if c.textureInspectors == nil {
+			c.textureInspectors = make(map[controlID]*textureInspectorState)
+		}
+		c.textureInspectors[id] = &textureInspectorState{
+			zoom: 1,
+		}
+	}
+	return c.textureInspectors[id]
+}
+
+// channelColorM returns a color matrix that shows only the given channel as grayscale.
+// Channel 0 means all the channels.
+func channelColorM(channel int) colorm.ColorM {
+	var cm colorm.ColorM
+	if channel == 0 {
+		return cm
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			var v float64
+			if i < 3 && j == channel-1 {
+				v = 1
+			}
+			cm.SetElement(i, j, v)
+		}
+	}
+	// make the result opaque
+	cm.SetElement(3, 4, 1)
+	return cm
+}
+
+// TextureInspector renders a window showing img for inspection.
+// The mouse wheel zooms around the cursor, dragging pans, and the channel buttons isolate a single channel.
+// The coordinate and the RGBA value of the hovered pixel are shown at the bottom. The value is premultiplied by alpha.
+func (c *Context) TextureInspector(name string, img *ebiten.Image) {
+	title, idStr, _ := strings.Cut(name, idSeparator)
+	rect := image.Rect(40, 40, 440, 440)
+	c.window(title, idStr, rect, optionNoScroll, nil, func(res Response, layout Layout) {
+		id := c.idFromBytes([]byte("!texture"))
+		st := c.textureInspectorState(id)
+
+		// channel buttons and zoom
+		bw := lineHeight() + c.style.padding*2
+		c.SetLayoutRow([]int{textWidth(textureChannelLabels[0]) + c.style.padding*2, bw, bw, bw, bw, textWidth("1:1") + c.style.padding*2, -1}, 0)
+		for i, label := range textureChannelLabels {
+			if c.button(label, "!channel"+label, optionAlignCenter) != 0 {
+				st.channel = i
+			}
+			if st.channel == i {
+				c.drawFrame(c.lastRect, ColorButtonFocus)
+				c.drawControlText(label, c.lastRect, ColorText, optionAlignCenter)
+			}
+		}
+		if c.button("1:1", "!reset", optionAlignCenter) != 0 {
+			st.zoom = 1
+			st.offset = [2]float64{}
+		}
+		c.Label(fmt.Sprintf("x%.2f", st.zoom))
+
+		// image view
+		c.SetLayoutRow([]int{-1}, -(c.style.size.Y + c.style.padding*2 + c.style.spacing))
+		var readout string
+		c.control(id, 0, func(r image.Rectangle) Response {
+			// zoom around the cursor
+			if c.mouseOver(r) && c.scrollDelta.Y != 0 {
+				zoom := clampF(st.zoom*math.Pow(1.25, float64(-c.scrollDelta.Y)/30), 1.0/16, 256)
+				mx := float64(c.mousePos.X - r.Min.X)
+				my := float64(c.mousePos.Y - r.Min.Y)
+				st.offset[0] = mx - (mx-st.offset[0])*zoom/st.zoom
+				st.offset[1] = my - (my-st.offset[1])*zoom/st.zoom
+				st.zoom = zoom
+				c.scrollDelta = image.Point{}
+			}
+			// pan by dragging
+			if c.focus == id && c.mouseDown == mouseLeft {
+				st.offset[0] += float64(c.mouseDelta.X)
+				st.offset[1] += float64(c.mouseDelta.Y)
+			}
+
+			// draw
+			c.drawFrame(r, ColorBase)
+			ox := float64(r.Min.X) + st.offset[0]
+			oy := float64(r.Min.Y) + st.offset[1]
+			zoom := st.zoom
+			cm := channelColorM(st.channel)
+			c.pushClipRect(r)
+			c.DrawControl(func(screen *ebiten.Image) {
+				op := &colorm.DrawImageOptions{}
+				op.GeoM.Scale(zoom, zoom)
+				op.GeoM.Translate(ox, oy)
+				op.Filter = ebiten.FilterNearest
+				colorm.DrawImage(screen, img, cm, op)
+			})
+			c.popClipRect()
+
+			// read the hovered pixel
+			if c.mouseOver(r) {
+				b := img.Bounds()
+				x := b.Min.X + int(math.Floor((float64(c.mousePos.X)-ox)/zoom))
+				y := b.Min.Y + int(math.Floor((float64(c.mousePos.Y)-oy)/zoom))
+				if image.Pt(x, y).In(b) {
+					pix := make([]byte, 4)
+					img.SubImage(image.Rect(x, y, x+1, y+1)).(*ebiten.Image).ReadPixels(pix)
+					readout = fmt.Sprintf("(%d, %d) R:%d G:%d B:%d A:%d", x, y, pix[0], pix[1], pix[2], pix[3])
+				}
+			}
+			return 0
+		})
+
+		// pixel readout
+		c.SetLayoutRow([]int{-1}, 0)
+		c.Label(readout)
+	})
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"image"
+)
+
+const tooltipName = "!tooltip"
+
+// Tooltip shows text in a popup next to the mouse cursor while the previous control is hovered.
+// The tooltip appears after the mouse has hovered the control for the tooltip delay.
+func (c *Context) Tooltip(text string) {
+	if !c.mouseOver(c.lastRect) || c.mouseDown != 0 {
+		if c.tooltipRect == c.lastRect {
+			c.tooltipRect = image.Rectangle{}
+		}
+		return
+	}
+	if c.tooltipRect != c.lastRect {
+		c.tooltipRect = c.lastRect
+		c.tooltipTick = c.tick
+	}
+	if c.tick-c.tooltipTick < c.tooltipDelay {
+		return
+	}
+
+	lines := wrapLines(text, tooltipMaxWidth)
+	var w int
+	for _, ln := range lines {
+		w = max(w, textWidth(text[ln.start:ln.end]))
+	}
+	lh := lineHeight()
+	c.floatingWindow(tooltipName, c.mousePos.Add(image.Pt(tooltipOffset, tooltipOffset)), func() {
+		c.SetLayoutRow([]int{w}, len(lines)*lh)
+		c.control(0, 0, func(r image.Rectangle) Response {
+			for i, ln := range lines {
+				c.drawText(text[ln.start:ln.end], image.Pt(r.Min.X, r.Min.Y+i*lh), c.style.colors[ColorText])
+			}
+			return 0
+		})
+	})
+}
+
+// floatingWindow renders a root container named name at pos above everything else, and calls f to render its content.
+// The container is sized to fit the content, which is measured in the previous frame.
+func (c *Context) floatingWindow(name string, pos image.Point, f func()) {
+	cnt := c.container(c.idFromBytes([]byte(name)), 0)
+	cnt.layout.Rect = cnt.layout.Rect.Add(pos.Sub(cnt.layout.Rect.Min))
+	cnt.open = true
+	c.bringToFront(cnt)
+
+	// start with an empty body until the content is measured
+	rect := image.Rectangle{Min: pos, Max: pos.Add(image.Pt(c.style.padding*2, c.style.padding*2))}
+	opt := optionNoTitle | optionNoResize | optionNoScroll | optionAutoSize
+	c.window("", name, rect, opt, nil, func(res Response, layout Layout) {
+		f()
+	})
+}
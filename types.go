@@ -6,6 +6,9 @@ package debugui
 import (
 	"image"
 	"image/color"
+	"reflect"
+	"runtime"
+	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/exp/textinput"
@@ -51,6 +54,12 @@ type drawCommand struct {
 	f func(screen *ebiten.Image)
 }
 
+type imageCommand struct {
+	rect  image.Rectangle
+	img   *ebiten.Image
+	color color.Color
+}
+
 type layout struct {
 	body      image.Rectangle
 	position  image.Point
@@ -63,15 +72,91 @@ type layout struct {
 }
 
 type command struct {
-	typ  int
-	idx  int
-	base baseCommand // type 0 (TODO)
-	jump jumpCommand // type 1
-	clip clipCommand // type 2
-	rect rectCommand // type 3
-	text textCommand // type 4
-	icon iconCommand // type 5
-	draw drawCommand // type 6
+	typ   int
+	idx   int
+	base  baseCommand  // type 0 (TODO)
+	jump  jumpCommand  // type 1
+	clip  clipCommand  // type 2
+	rect  rectCommand  // type 3
+	text  textCommand  // type 4
+	icon  iconCommand  // type 5
+	draw  drawCommand  // type 6
+	image imageCommand // type 7
+}
+
+// textLine is a visual line of wrapped text.
+// The visible text is [start, end) and the next line starts at next.
+type textLine struct {
+	start int
+	end   int
+	next  int
+}
+
+type undoEntry struct {
+	id     controlID
+	before any
+	label  string
+	undo   func()
+	redo   func()
+}
+
+type textureInspectorState struct {
+	zoom    float64
+	offset  [2]float64
+	channel int
+}
+
+type vecState struct {
+	locked   [4]bool
+	defaults [4]float64
+}
+
+// cursorState is the state of the cursor shape shared with the game.
+// While owned, debugui controls the cursor shape, and game is the shape to restore.
+type cursorState struct {
+	owned bool
+	game  ebiten.CursorShapeType
+}
+
+type focusEntry struct {
+	id   controlID
+	rect image.Rectangle
+	cnt  *container
+	root *container
+}
+
+type paletteCommand struct {
+	name     string
+	category string
+	action   func()
+}
+
+type shortcut struct {
+	mods        uint64
+	key         ebiten.Key
+	chord       string
+	description string
+	action      func()
+}
+
+type tableState struct {
+	widths     []int
+	sortColumn int
+	ascending  bool
+}
+
+// inspectProxyKey identifies a non-float64 numeric value edited through a float64 proxy.
+type inspectProxyKey struct {
+	addr uintptr
+	kind reflect.Kind
+}
+
+// inspectProxy is a float64 copy of a numeric value, so that it can be edited by Number and Slider.
+// The proxy is retained across frames as its address is used as the control ID.
+type inspectProxy struct {
+	value      float64
+	synced     float64
+	lastUpdate int
 }
 
 type Layout struct {
@@ -81,6 +166,65 @@ type Layout struct {
 	Scroll      image.Point
 }
 
+// WindowOptions represents options for WindowWithOptions.
+type WindowOptions struct {
+	// MinSize is the minimum size of the window. A zero component means the default minimum.
+	MinSize image.Point
+
+	// MaxSize is the maximum size of the window. A zero component means no limit.
+	MaxSize image.Point
+
+	// Snap makes the window snap to the edges of the other windows and the screen while it is moved or resized.
+	Snap bool
+
+	// KeepOnScreen keeps at least the title bar of the window inside the screen,
+	// even after the screen gets smaller.
+	KeepOnScreen bool
+}
+
+// TextBoxOptions represents options for TextBoxWithOptions.
+type TextBoxOptions struct {
+	// Password masks the text with '*' while the buffer keeps the actual text.
+	// The text of a password text box cannot be copied.
+	Password bool
+
+	// ReadOnly prevents editing the text. The text can still be selected and copied.
+	ReadOnly bool
+
+	// Filter reports whether a typed or pasted rune is accepted. If Filter is nil, all runes are accepted.
+	Filter func(r rune) bool
+
+	// Validate reports whether the text is valid. An invalid text box is drawn with ColorInvalid and cannot be submitted.
+	// If Validate is nil, any text is valid.
+	Validate func(text string) bool
+}
+
+// ImageMode represents how an image is scaled into the rectangle of a control.
+type ImageMode int
+
+const (
+	// ImageModeFit scales the image to fit in the control, keeping the aspect ratio.
+	ImageModeFit ImageMode = iota
+
+	// ImageModeFill scales the image to fill the control, keeping the aspect ratio. The overflow is clipped.
+	ImageModeFill
+
+	// ImageModeOriginal draws the image at its original size, centered. The overflow is clipped.
+	ImageModeOriginal
+)
+
+// ImageOptions represents options for Image and ImageButton.
+type ImageOptions struct {
+	// Mode is the scaling mode. The default is ImageModeFit.
+	Mode ImageMode
+
+	// Tint scales the colors of the image. If Tint is nil, the image is drawn as it is.
+	Tint color.Color
+
+	// Source is the sub-rectangle of the image to draw, in pixels. If Source is empty, the whole image is drawn.
+	Source image.Rectangle
+}
+
 type style struct {
 	size          image.Point
 	padding       int
@@ -89,6 +233,7 @@ type style struct {
 	titleHeight   int
 	scrollbarSize int
 	thumbSize     int
+	touchSlop     int
 	colors        [ColorMax + 1]color.RGBA
 }
 
@@ -106,17 +251,38 @@ type Context struct {
 	hoverRoot     *container
 	nextHoverRoot *container
 	scrollTarget  *container
+	rootContainer *container
 	numberEditBuf string
 	numberEdit    controlID
+	textAnchor    int
+	tooltipRect   image.Rectangle
+	tooltipTick   int
+	tooltipDelay  int
+	clipboard     Clipboard
+	undoSealed    bool
+	navFocus      bool
+	scrollToFocus bool
+
+	paletteOpen     bool
+	paletteOpened   bool
+	paletteQuery    string
+	paletteSelected int
 
 	// stacks
 
 	commandList    []*command
 	rootList       []*container
+	prevRootList   []*container
 	containerStack []*container
 	clipStack      []image.Rectangle
 	idStack        []controlID
 	layoutStack    []layout
+	undoStack      []undoEntry
+	redoStack      []undoEntry
+	focusOrder     []focusEntry
+	shortcuts      []shortcut
+
+	paletteCommands []paletteCommand
 
 	// retained state pools
 
@@ -132,8 +298,58 @@ type Context struct {
 	scrollDelta  image.Point
 	mouseDown    int
 	mousePressed int
-	keyDown      int
-	keyPressed   int
+	keyDown      uint64
+	keyPressed   uint64
+	keysPressed  [ebiten.KeyMax + 1]bool
+	keys         []ebiten.Key
+
+	gamepadPressed int
+	shortcutKeys   []ebiten.Key
+	gamepadIDs     []ebiten.GamepadID
+	textEditing    bool
+
+	cursorPos         image.Point
+	touches           map[ebiten.TouchID]image.Point
+	touchIDs          []ebiten.TouchID
+	primaryTouch      ebiten.TouchID
+	touchStart        image.Point
+	touchStartTick    int
+	touchPressPending bool
+	touchHoldDone     bool
+	touchInput        bool
+
+	nextCursor   ebiten.CursorShapeType
+	cursorShape  ebiten.CursorShapeType
+	cursorOverUI bool
+	cursor       cursorState
+
+	dragPending     bool
+	dragging        bool
+	dragStart       image.Point
+	dragPayloadType string
+	dragPayload     any
+
+	lastClickTick int
+	lastClickPos  image.Point
+	clickCount    int
+
+	textFields  map[controlID]*textinput.Field
+	vecStates   map[controlID]*vecState
+	tableStates map[controlID]*tableState
+
+	inspectProxies map[inspectProxyKey]*inspectProxy
+
+	textureInspectors map[controlID]*textureInspectorState
+	perf              []perfMetric
+	perfMemStats      runtime.MemStats
+	perfMemStatsTime  time.Time
+
+	// statistics
 
-	textFields map[controlID]*textinput.Field
+	updateDuration     time.Duration
+	drawDuration       time.Duration
+	gameUpdateDuration time.Duration
+	gameDrawDuration   time.Duration
+	lastCommandCount   int
+	screenSize         image.Point
 }
@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"fmt"
+	"image"
+	"strconv"
+)
+
+// recordUndo records the change of *value from before to its current value in the undo history.
+// Successive changes by the same control are coalesced into a single entry until the focus changes,
+// so that a whole drag or a whole text edit is undone at once.
+func recordUndo[T comparable](c *Context, id controlID, value *T, before T) {
+	recordUndoWithLabel(c, id, value, before, undoLabel[T])
+}
+
+// undoLabel returns the label of an undo entry changing a value from before to after.
+func undoLabel[T any](before, after T) string {
+	return undoValueLabel(before) + " -> " + undoValueLabel(after)
+}
+
+// undoValueLabel returns v formatted for an undo entry label.
+// Strings are quoted so that newlines are escaped, and truncated to undoLabelMaxLength runes.
+func undoValueLabel(v any) string {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Sprint(v)
+	}
+	if r := []rune(s); len(r) > undoLabelMaxLength {
+		return strconv.Quote(string(r[:undoLabelMaxLength])) + "..."
+	}
+	return strconv.Quote(s)
+}
+
+// recordMaskedUndo is like recordUndo, but the values are not shown in the label of the entry.
+func recordMaskedUndo[T comparable](c *Context, id controlID, value *T, before T) {
+	recordUndoWithLabel(c, id, value, before, func(before, after T) string {
+		return "(masked)"
+	})
+}
+
+// recordUndoWithLabel records the change of *value from before to its current value in the undo history,
+// labeling the entry by label.
+func recordUndoWithLabel[T comparable](c *Context, id controlID, value *T, before T, label func(before, after T) string) {
+	recordUndoFunc(c, id, before, *value, func(v T) { *value = v }, label)
+}
+
+// recordUndoFunc records the change of a value from before to after in the undo history, labeling the entry by label.
+// set is called to restore the value by undo and redo. This is used for values not reachable by a pointer.
+func recordUndoFunc[T comparable](c *Context, id controlID, before, after T, set func(v T), label func(before, after T) string) {
+	if after == before {
+		return
+	}
+	c.redoStack = c.redoStack[:0]
+
+	if n := len(c.undoStack); n > 0 && !c.undoSealed && c.undoStack[n-1].id == id {
+		e := &c.undoStack[n-1]
+		e.redo = func() { set(after) }
+		e.label = label(e.before.(T), after)
+		return
+	}
+
+	c.undoStack = append(c.undoStack, undoEntry{
+		id:     id,
+		before: before,
+		label:  label(before, after),
+		undo:   func() { set(before) },
+		redo:   func() { set(after) },
+	})
+	if len(c.undoStack) > undoHistorySize {
+		c.undoStack = c.undoStack[len(c.undoStack)-undoHistorySize:]
+	}
+	c.undoSealed = false
+}
+
+// Undo reverts the last recorded value change.
+// The focus is removed so that the restored value is not overwritten by a control being edited.
+func (c *Context) Undo() {
+	if len(c.undoStack) == 0 {
+		return
+	}
+	e := c.undoStack[len(c.undoStack)-1]
+	c.undoStack = c.undoStack[:len(c.undoStack)-1]
+	e.undo()
+	c.redoStack = append(c.redoStack, e)
+	c.setFocus(0)
+}
+
+// Redo reapplies the last value change reverted by Undo.
+func (c *Context) Redo() {
+	if len(c.redoStack) == 0 {
+		return
+	}
+	e := c.redoStack[len(c.redoStack)-1]
+	c.redoStack = c.redoStack[:len(c.redoStack)-1]
+	e.redo()
+	c.undoStack = append(c.undoStack, e)
+	c.setFocus(0)
+}
+
+// handleUndoKeys handles ctrl+Z for undo and ctrl+Y or ctrl+shift+Z for redo.
+func (c *Context) handleUndoKeys() {
+	if (c.keyDown & keyControl) == 0 {
+		return
+	}
+	switch {
+	case (c.keyPressed&keyZ) != 0 && (c.keyDown&keyShift) != 0:
+		c.Redo()
+	case (c.keyPressed & keyZ) != 0:
+		c.Undo()
+	case (c.keyPressed & keyY) != 0:
+		c.Redo()
+	}
+}
+
+// UndoWindow renders a window listing the undo history.
+// Clicking an entry undoes or redoes changes so that the entry becomes the last applied change.
+func (c *Context) UndoWindow(rect image.Rectangle) {
+	c.Window("Undo History", rect, func(res Response, layout Layout) {
+		c.SetLayoutRow([]int{0, 0}, 0)
+		if c.Button("Undo") != 0 {
+			c.Undo()
+		}
+		if c.Button("Redo") != 0 {
+			c.Redo()
+		}
+
+		// entries are listed from the oldest to the newest
+		undo, redo := -1, -1
+		c.SetLayoutRow([]int{-1}, 0)
+		for i, e := range c.undoStack {
+			if c.Button(fmt.Sprintf("%s\x00undo%d", e.label, i)) != 0 {
+				undo = len(c.undoStack) - i - 1
+			}
+		}
+		for i := len(c.redoStack) - 1; i >= 0; i-- {
+			if c.Button(fmt.Sprintf("(undone) %s\x00redo%d", c.redoStack[i].label, i)) != 0 {
+				redo = len(c.redoStack) - i
+			}
+		}
+
+		for ; undo > 0; undo-- {
+			c.Undo()
+		}
+		for ; redo > 0; redo-- {
+			c.Redo()
+		}
+	})
+}
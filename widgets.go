@@ -6,6 +6,8 @@ package debugui
 import (
 	"image"
 	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const idSeparator = "\x00"
@@ -16,7 +18,29 @@ func (c *Context) Button(label string) Response {
 }
 
 func (c *Context) TextBox(buf *string) Response {
-	return c.textBox(buf, 0)
+	return c.textBox(buf, 0, nil, nil)
+}
+
+func (c *Context) TextBoxWithOptions(buf *string, options *TextBoxOptions) Response {
+	var opt option
+	if options != nil {
+		if options.Password {
+			opt |= optionPassword
+		}
+		if options.ReadOnly {
+			opt |= optionReadOnly
+		}
+		return c.textBox(buf, opt, options.Filter, options.Validate)
+	}
+	return c.textBox(buf, opt, nil, nil)
+}
+
+func (c *Context) TextBoxFiltered(buf *string, filter func(r rune) bool) Response {
+	return c.textBox(buf, 0, filter, nil)
+}
+
+func (c *Context) TextArea(buf *string, height int) Response {
+	return c.textArea(buf, height, 0)
 }
 
 func (c *Context) Slider(value *float64, lo, hi float64, step float64, digits int) Response {
@@ -27,6 +51,38 @@ func (c *Context) Number(value *float64, step float64, digits int) Response {
 	return c.number(value, step, digits, optionAlignCenter)
 }
 
+func (c *Context) Vec2(value *[2]float64, step float64, digits int) Response {
+	return c.vec(value[:], step, digits, optionAlignCenter)
+}
+
+func (c *Context) Vec3(value *[3]float64, step float64, digits int) Response {
+	return c.vec(value[:], step, digits, optionAlignCenter)
+}
+
+func (c *Context) Vec4(value *[4]float64, step float64, digits int) Response {
+	return c.vec(value[:], step, digits, optionAlignCenter)
+}
+
+func (c *Context) ProgressBar(fraction float64, overlay string) {
+	c.progressBar(fraction, overlay, optionAlignCenter)
+}
+
+func (c *Context) Image(img *ebiten.Image, options *ImageOptions) {
+	c.image(img, options, 0)
+}
+
+func (c *Context) ImageButton(img *ebiten.Image, options *ImageOptions) Response {
+	return c.imageButton(img, options, 0)
+}
+
+func (c *Context) PlotLines(label string, values []float64, options *PlotOptions) {
+	c.plot(label, values, options, false, false)
+}
+
+func (c *Context) PlotHistogram(label string, values []float64, options *PlotOptions) {
+	c.plot(label, values, options, true, false)
+}
+
 func (c *Context) Header(label string, expanded bool) Response {
 	var opt option
 	if expanded {
@@ -43,9 +99,21 @@ func (c *Context) TreeNode(label string, f func(res Response)) {
 
 func (c *Context) Window(title string, rect image.Rectangle, f func(res Response, layout Layout)) {
 	title, idStr, _ := strings.Cut(title, idSeparator)
-	c.window(title, idStr, rect, 0, f)
+	c.window(title, idStr, rect, 0, nil, f)
+}
+
+func (c *Context) WindowWithOptions(title string, rect image.Rectangle, options *WindowOptions, f func(res Response, layout Layout)) {
+	title, idStr, _ := strings.Cut(title, idSeparator)
+	c.window(title, idStr, rect, 0, options, f)
 }
 
 func (c *Context) Panel(name string, f func(layout Layout)) {
 	c.panel(name, 0, f)
 }
+
+// Table renders a table with sortable, resizable columns in the next layout cell.
+// f is called for each visible cell and must render exactly one control for the cell.
+// Table returns the column to sort the rows by, which is -1 until a header is clicked, and whether the order is ascending.
+func (c *Context) Table(id string, columns []TableColumn, rowCount int, f func(row, col int)) (sortColumn int, ascending bool) {
+	return c.table(id, columns, rowCount, f)
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2024 The Ebitengine Authors
+
+package debugui
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// windowResizeHandle is a handle at an edge or a corner of a window to resize it.
+// dir is the direction of the edges moved by the handle.
+type windowResizeHandle struct {
+	name   string
+	dir    image.Point
+	cursor ebiten.CursorShapeType
+}
+
+// windowResizeHandles are the resize handles of a window.
+// The bottom-right handle is the last so that it takes precedence over the edges it overlaps.
+var windowResizeHandles = [...]windowResizeHandle{
+	{"!resize-n", image.Pt(0, -1), ebiten.CursorShapeNSResize},
+	{"!resize-s", image.Pt(0, 1), ebiten.CursorShapeNSResize},
+	{"!resize-w", image.Pt(-1, 0), ebiten.CursorShapeEWResize},
+	{"!resize-e", image.Pt(1, 0), ebiten.CursorShapeEWResize},
+	{"!resize-nw", image.Pt(-1, -1), ebiten.CursorShapeNWSEResize},
+	{"!resize-ne", image.Pt(1, -1), ebiten.CursorShapeNESWResize},
+	{"!resize-sw", image.Pt(-1, 1), ebiten.CursorShapeNESWResize},
+	{"!resize", image.Pt(1, 1), ebiten.CursorShapeNWSEResize},
+}
+
+// resizeHandleRect returns the rectangle of the resize handle in the direction dir of the window rect.
+// Edges are thin bands inside the window, and corners are small squares.
+// The bottom-right corner is as large as the title bar height.
+func (c *Context) resizeHandleRect(rect image.Rectangle, dir image.Point) image.Rectangle {
+	b := windowResizeBorder
+	k := b * 2
+	if dir == image.Pt(1, 1) {
+		k = c.style.titleHeight
+	}
+	span := func(lo, hi, d, other int) (int, int) {
+		t := b
+		if other != 0 {
+			t = k
+		}
+		switch d {
+		case -1:
+			return lo, lo + t
+		case 1:
+			return hi - t, hi
+		}
+		return lo + k, hi - k
+	}
+	x0, x1 := span(rect.Min.X, rect.Max.X, dir.X, dir.Y)
+	y0, y1 := span(rect.Min.Y, rect.Max.Y, dir.Y, dir.X)
+	return image.Rect(x0, y0, x1, y1)
+}
+
+// windowSizeLimits returns the minimum and maximum size of a window set by options. A zero component means no limit.
+// If resizing is true, the minimum defaults to windowMinWidth and windowMinHeight,
+// so that a window resized by its handles doesn't collapse.
+func windowSizeLimits(options *WindowOptions, resizing bool) (image.Point, image.Point) {
+	var minSize, maxSize image.Point
+	if options != nil {
+		minSize = options.MinSize
+		maxSize = options.MaxSize
+	}
+	if resizing {
+		if minSize.X <= 0 {
+			minSize.X = windowMinWidth
+		}
+		if minSize.Y <= 0 {
+			minSize.Y = windowMinHeight
+		}
+	}
+	return minSize, maxSize
+}
+
+// constrainWindowSize returns r resized to the size limits minSize and maxSize. A zero component means no limit.
+// The edges in the direction dir move, and the opposite edges stay in place.
+func constrainWindowSize(r image.Rectangle, dir image.Point, minSize, maxSize image.Point) image.Rectangle {
+	w := max(r.Dx(), minSize.X)
+	if maxSize.X > 0 {
+		w = min(w, maxSize.X)
+	}
+	h := max(r.Dy(), minSize.Y)
+	if maxSize.Y > 0 {
+		h = min(h, maxSize.Y)
+	}
+	if dir.X < 0 {
+		r.Min.X = r.Max.X - w
+	} else {
+		r.Max.X = r.Min.X + w
+	}
+	if dir.Y < 0 {
+		r.Min.Y = r.Max.Y - h
+	} else {
+		r.Max.Y = r.Min.Y + h
+	}
+	return r
+}
+
+// dragWindow moves the window cnt by the mouse if dir is zero, or resizes it by moving the edges in the direction dir.
+// The unconstrained rectangle is kept while dragging, so that the window follows the mouse again after it is
+// released from a size limit or a snap.
+func (c *Context) dragWindow(cnt *container, dir image.Point, options *WindowOptions) {
+	if c.mousePressed == mouseLeft {
+		cnt.dragRect = cnt.layout.Rect
+	}
+	r := cnt.dragRect
+	d := c.mouseDelta
+	if dir == (image.Point{}) {
+		r = r.Add(d)
+	} else {
+		if dir.X < 0 {
+			r.Min.X += d.X
+		} else if dir.X > 0 {
+			r.Max.X += d.X
+		}
+		if dir.Y < 0 {
+			r.Min.Y += d.Y
+		} else if dir.Y > 0 {
+			r.Max.Y += d.Y
+		}
+	}
+	cnt.dragRect = r
+
+	minSize, maxSize := windowSizeLimits(options, true)
+	if dir != (image.Point{}) {
+		r = constrainWindowSize(r, dir, minSize, maxSize)
+	}
+	if options != nil && options.Snap {
+		r = c.snapWindow(cnt, r, dir)
+		if dir != (image.Point{}) {
+			r = constrainWindowSize(r, dir, minSize, maxSize)
+		}
+	}
+	cnt.layout.Rect = r
+}
+
+// snapWindow returns r snapped to the edges of the other windows and the screen within windowSnapDistance.
+// If dir is zero, the window is moved. Otherwise, only the edges in the direction dir are snapped.
+func (c *Context) snapWindow(cnt *container, r image.Rectangle, dir image.Point) image.Rectangle {
+	const dist = windowSnapDistance
+
+	var xs, ys []int
+	if c.screenSize != (image.Point{}) {
+		xs = append(xs, 0, c.screenSize.X)
+		ys = append(ys, 0, c.screenSize.Y)
+	}
+	for _, other := range c.prevRootList {
+		if other == cnt || !other.open {
+			continue
+		}
+		o := other.layout.Rect
+		// snap only to windows nearby on the other axis
+		if r.Min.Y < o.Max.Y+dist && r.Max.Y > o.Min.Y-dist {
+			xs = append(xs, o.Min.X, o.Max.X)
+		}
+		if r.Min.X < o.Max.X+dist && r.Max.X > o.Min.X-dist {
+			ys = append(ys, o.Min.Y, o.Max.Y)
+		}
+	}
+
+	// snapOffset returns the offset to the nearest line from any of the values, or 0 if none is close enough.
+	snapOffset := func(lines []int, values ...int) int {
+		best := dist + 1
+		for _, v := range values {
+			for _, l := range lines {
+				if o := l - v; abs(o) < abs(best) {
+					best = o
+				}
+			}
+		}
+		if abs(best) > dist {
+			return 0
+		}
+		return best
+	}
+
+	if dir == (image.Point{}) {
+		return r.Add(image.Pt(snapOffset(xs, r.Min.X, r.Max.X), snapOffset(ys, r.Min.Y, r.Max.Y)))
+	}
+	switch {
+	case dir.X < 0:
+		r.Min.X += snapOffset(xs, r.Min.X)
+	case dir.X > 0:
+		r.Max.X += snapOffset(xs, r.Max.X)
+	}
+	switch {
+	case dir.Y < 0:
+		r.Min.Y += snapOffset(ys, r.Min.Y)
+	case dir.Y > 0:
+		r.Max.Y += snapOffset(ys, r.Max.Y)
+	}
+	return r
+}
+
+// keepOnScreen returns r moved so that its title bar is inside the screen.
+// If the window is wider than the screen, its left edge is kept inside the screen instead.
+func (c *Context) keepOnScreen(r image.Rectangle) image.Rectangle {
+	if c.screenSize == (image.Point{}) {
+		return r
+	}
+	var d image.Point
+	if r.Max.X > c.screenSize.X {
+		d.X = c.screenSize.X - r.Max.X
+	}
+	if r.Min.X+d.X < 0 {
+		d.X = -r.Min.X
+	}
+	if r.Min.Y+c.style.titleHeight > c.screenSize.Y {
+		d.Y = c.screenSize.Y - c.style.titleHeight - r.Min.Y
+	}
+	if r.Min.Y+d.Y < 0 {
+		d.Y = -r.Min.Y
+	}
+	return r.Add(d)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}